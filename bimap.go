@@ -0,0 +1,116 @@
+package kit
+
+import "fmt"
+
+// BiMap 双向映射，键和值都是唯一的，可以通过值反查键。零值可以直接使用。
+type BiMap[K comparable, V comparable] struct {
+	forward map[K]V
+	reverse map[V]K
+}
+
+// NewBiMap 创建双向映射。
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: make(map[K]V),
+		reverse: make(map[V]K),
+	}
+}
+
+// Put 设置键值对。如果值已经属于其他的键，则拒绝写入并返回错误。
+// 如果键已经存在，旧的值会被替换。
+func (m *BiMap[K, V]) Put(key K, value V) error {
+	if k, ok := m.reverse[value]; ok && k != key {
+		return fmt.Errorf("biMap: value %v already bound to key %v", value, k)
+	}
+
+	m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut 设置键值对。如果值已经属于其他的键，则删除该键原有的映射。
+func (m *BiMap[K, V]) ForcePut(key K, value V) {
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.reverse = make(map[V]K)
+	}
+
+	m.DeleteKey(key)
+	m.DeleteValue(value)
+
+	m.forward[key] = value
+	m.reverse[value] = key
+}
+
+// Get 返回键对应的值，键不存在时返回零值和 false。
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetKey 返回值对应的键，值不存在时返回零值和 false。
+func (m *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := m.reverse[value]
+	return k, ok
+}
+
+// HasKey 判断键是否存在。
+func (m *BiMap[K, V]) HasKey(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+// HasValue 判断值是否存在。
+func (m *BiMap[K, V]) HasValue(value V) bool {
+	_, ok := m.reverse[value]
+	return ok
+}
+
+// DeleteKey 删除键及其对应的值，返回键是否存在。
+func (m *BiMap[K, V]) DeleteKey(key K) bool {
+	v, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.reverse, v)
+	}
+	return ok
+}
+
+// DeleteValue 删除值及其对应的键，返回值是否存在。
+func (m *BiMap[K, V]) DeleteValue(value V) bool {
+	k, ok := m.reverse[value]
+	if ok {
+		delete(m.reverse, value)
+		delete(m.forward, k)
+	}
+	return ok
+}
+
+// Len 返回键值对的个数。
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Keys 返回所有的键，顺序是随机的。
+func (m *BiMap[K, V]) Keys() []K {
+	return Keys(m.forward)
+}
+
+// Values 返回所有的值，顺序是随机的。
+func (m *BiMap[K, V]) Values() []V {
+	return Keys(m.reverse)
+}
+
+// Inverse 返回键值互换后的双向映射，与原映射共享底层数据，对其中一个的修改会反映到另一个上。
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if m.forward == nil {
+		m.forward = make(map[K]V)
+		m.reverse = make(map[V]K)
+	}
+
+	return &BiMap[V, K]{forward: m.reverse, reverse: m.forward}
+}
+
+// ToMap 返回从键到值的 map 拷贝。
+func (m *BiMap[K, V]) ToMap() map[K]V {
+	return Assign(m.forward)
+}
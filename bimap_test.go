@@ -0,0 +1,74 @@
+package kit
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBiMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewBiMap[string, int]()
+	is.Nil(m.Put("a", 1))
+	is.Nil(m.Put("b", 2))
+	is.Nil(m.Put("a", 1))
+
+	v, ok := m.Get("a")
+	is.True(ok)
+	is.Equal(1, v)
+
+	k, ok := m.GetKey(2)
+	is.True(ok)
+	is.Equal("b", k)
+
+	// 值已经属于其他的键时拒绝写入
+	is.NotNil(m.Put("c", 1))
+	is.False(m.HasKey("c"))
+
+	// 替换键原有的值
+	is.Nil(m.Put("a", 3))
+	is.False(m.HasValue(1))
+	is.Equal(map[string]int{"a": 3, "b": 2}, m.ToMap())
+
+	// 强制写入时删除值原有的映射
+	m.ForcePut("c", 2)
+	is.False(m.HasKey("b"))
+	is.Equal(map[string]int{"a": 3, "c": 2}, m.ToMap())
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	is.Equal([]string{"a", "c"}, keys)
+
+	values := m.Values()
+	sort.Ints(values)
+	is.Equal([]int{2, 3}, values)
+
+	is.True(m.DeleteKey("a"))
+	is.False(m.DeleteKey("a"))
+	is.False(m.HasValue(3))
+
+	is.True(m.DeleteValue(2))
+	is.False(m.DeleteValue(2))
+	is.Equal(0, m.Len())
+}
+
+func TestBiMapInverse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var m BiMap[string, int]
+	inverse := m.Inverse()
+
+	is.Nil(m.Put("a", 1))
+	is.Nil(inverse.Put(2, "b"))
+
+	is.Equal(map[string]int{"a": 1, "b": 2}, m.ToMap())
+	is.Equal(map[int]string{1: "a", 2: "b"}, inverse.ToMap())
+
+	k, ok := inverse.Get(1)
+	is.True(ok)
+	is.Equal("a", k)
+}
@@ -0,0 +1,408 @@
+package kit
+
+import (
+	"sync"
+	"time"
+)
+
+// EvictionPolicy 缓存的淘汰策略。
+type EvictionPolicy int
+
+const (
+	// EvictLRU 淘汰最久未被访问的元素。
+	EvictLRU EvictionPolicy = iota
+	// EvictLFU 淘汰访问次数最少的元素。
+	EvictLFU
+	// EvictARC 使用自适应替换算法，在 LRU 和 LFU 之间动态平衡。
+	EvictARC
+)
+
+// EvictReason 元素被移出缓存的原因。
+type EvictReason int
+
+const (
+	// EvictReasonCapacity 因超出数量或成本上限被淘汰。
+	EvictReasonCapacity EvictReason = iota
+	// EvictReasonExpired 因过期被删除。
+	EvictReasonExpired
+	// EvictReasonDeleted 被主动删除。
+	EvictReasonDeleted
+)
+
+// CacheConfig 缓存配置，所有字段均为可选。
+type CacheConfig[K comparable, V any] struct {
+	// Policy 淘汰策略，默认为 EvictLRU。
+	Policy EvictionPolicy
+	// Capacity 最多保存的元素个数，小于等于 0 表示不限制。
+	Capacity int
+	// MaxCost 所有元素的成本之和的上限，小于等于 0 表示不限制。
+	MaxCost int64
+	// Cost 计算元素的成本，默认每个元素的成本为 1。
+	Cost func(key K, value V) int64
+	// TTL 元素的默认存活时间，小于等于 0 表示永不过期。
+	TTL time.Duration
+	// CleanupInterval 后台清理过期元素的间隔，小于等于 0 表示只在访问时惰性删除。
+	CleanupInterval time.Duration
+	// OnEvict 元素被移出缓存时的回调，在释放锁之后调用。
+	OnEvict func(key K, value V, reason EvictReason)
+	// Clock 时间来源，默认为 SystemClock。
+	Clock Clock
+}
+
+// CacheStats 缓存的统计信息。
+type CacheStats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRate 返回缓存的命中率，没有任何访问时返回 0。
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Cache 并发安全的泛型缓存，支持多种淘汰策略、过期时间和成本限制。
+type Cache[K comparable, V any] struct {
+	mu     sync.Mutex
+	config CacheConfig[K, V]
+	items  map[K]*cacheItem[K, V]
+	policy evictionPolicy[K]
+	cost   int64
+	stats  CacheStats
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+type cacheItem[K comparable, V any] struct {
+	key      K
+	value    V
+	cost     int64
+	ttl      time.Duration
+	expireAt time.Time
+}
+
+type evictedItem[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictReason
+}
+
+// NewCache 根据配置创建缓存。
+// 如果配置了 CleanupInterval，会启动一个后台协程定期清理过期元素，不再使用时需要调用 Close。
+func NewCache[K comparable, V any](config CacheConfig[K, V]) *Cache[K, V] {
+	if config.Clock == nil {
+		config.Clock = SystemClock{}
+	}
+
+	c := &Cache[K, V]{
+		config: config,
+		items:  make(map[K]*cacheItem[K, V]),
+		policy: newEvictionPolicy[K](config.Policy, config.Capacity),
+		stop:   make(chan struct{}),
+	}
+
+	if config.CleanupInterval > 0 {
+		go c.cleanup(config.CleanupInterval)
+	}
+
+	return c
+}
+
+// Get 返回给定键的值，键不存在或已过期时返回零值和 false。
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+
+	item, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		c.mu.Unlock()
+		return Empty[V](), false
+	}
+
+	if c.expired(item) {
+		c.stats.Misses++
+		evicted := c.remove(item, EvictReasonExpired)
+		c.mu.Unlock()
+
+		c.notify([]evictedItem[K, V]{evicted})
+		return Empty[V](), false
+	}
+
+	c.stats.Hits++
+	c.policy.access(key)
+	value := item.value
+	c.mu.Unlock()
+
+	return value, true
+}
+
+// Peek 返回给定键的值，但不会更新访问记录和统计信息。
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if item, ok := c.items[key]; ok && !c.expired(item) {
+		return item.value, true
+	}
+	return Empty[V](), false
+}
+
+// Has 判断给定的键是否存在且未过期，不会更新访问记录和统计信息。
+func (c *Cache[K, V]) Has(key K) bool {
+	_, ok := c.Peek(key)
+	return ok
+}
+
+// Set 使用默认的存活时间设置键值对。
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.config.TTL)
+}
+
+// SetWithTTL 使用给定的存活时间设置键值对，ttl 小于等于 0 表示永不过期。
+func (c *Cache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+
+	cost := int64(1)
+	if c.config.Cost != nil {
+		cost = c.config.Cost(key, value)
+	}
+
+	expireAt := c.expireAt(ttl)
+
+	var evicted []evictedItem[K, V]
+
+	if item, ok := c.items[key]; ok {
+		c.cost += cost - item.cost
+		item.value, item.cost, item.ttl, item.expireAt = value, cost, ttl, expireAt
+		c.policy.access(key)
+	} else {
+		// 先为新元素腾出空间，避免新元素被淘汰策略选中
+		evicted = c.evict(1, cost)
+
+		c.items[key] = &cacheItem[K, V]{key: key, value: value, cost: cost, ttl: ttl, expireAt: expireAt}
+		c.cost += cost
+		c.policy.add(key)
+	}
+
+	// 更新后成本增加或单个元素的成本超出上限时继续淘汰
+	evicted = append(evicted, c.evict(0, 0)...)
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Touch 将未过期的键的存活时间重置为设置时的存活时间，返回键是否存在且未过期。
+// Touch 不会更新访问记录和统计信息。
+func (c *Cache[K, V]) Touch(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok || c.expired(item) {
+		return false
+	}
+
+	item.expireAt = c.expireAt(item.ttl)
+	return true
+}
+
+// Delete 删除给定的键，返回键是否存在且未过期。已过期的键以 EvictReasonExpired 的原因移除。
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+
+	item, ok := c.items[key]
+	if !ok {
+		c.mu.Unlock()
+		return false
+	}
+
+	reason := Ternary(c.expired(item), EvictReasonExpired, EvictReasonDeleted)
+	evicted := c.remove(item, reason)
+	c.mu.Unlock()
+
+	c.notify([]evictedItem[K, V]{evicted})
+	return reason == EvictReasonDeleted
+}
+
+// DeleteExpired 删除所有过期的元素，返回删除的个数。
+func (c *Cache[K, V]) DeleteExpired() int {
+	c.mu.Lock()
+
+	evicted := []evictedItem[K, V]{}
+	for _, item := range c.items {
+		if c.expired(item) {
+			evicted = append(evicted, c.remove(item, EvictReasonExpired))
+		}
+	}
+
+	c.mu.Unlock()
+
+	c.notify(evicted)
+	return len(evicted)
+}
+
+// TTL 返回给定键的剩余存活时间。永不过期的键返回 0 和 true，键不存在或已过期时返回 false。
+func (c *Cache[K, V]) TTL(key K) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item, ok := c.items[key]
+	if !ok || c.expired(item) {
+		return 0, false
+	}
+
+	if item.expireAt.IsZero() {
+		return 0, true
+	}
+	return item.expireAt.Sub(c.config.Clock.Now()), true
+}
+
+// Len 返回缓存中元素的个数，包括已过期但尚未被删除的元素。
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.items)
+}
+
+// Cost 返回缓存中所有元素的成本之和。
+func (c *Cache[K, V]) Cost() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.cost
+}
+
+// Keys 返回所有未过期的键，顺序是随机的。
+func (c *Cache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]K, 0, len(c.items))
+	for key, item := range c.items {
+		if !c.expired(item) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// Snapshot 返回所有未过期的键值对组成的 map，不会更新访问记录和统计信息。
+func (c *Cache[K, V]) Snapshot() map[K]V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make(map[K]V, len(c.items))
+	for key, item := range c.items {
+		if !c.expired(item) {
+			result[key] = item.value
+		}
+	}
+
+	return result
+}
+
+// Purge 清空缓存，不会触发 OnEvict 回调，也不会重置统计信息。
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[K]*cacheItem[K, V])
+	c.policy = newEvictionPolicy[K](c.config.Policy, c.config.Capacity)
+	c.cost = 0
+}
+
+// Stats 返回缓存的统计信息。
+func (c *Cache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// Close 停止后台清理协程，可以重复调用。关闭后缓存仍然可以正常读写。
+func (c *Cache[K, V]) Close() {
+	c.closeOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
+func (c *Cache[K, V]) cleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.DeleteExpired()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *Cache[K, V]) expireAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return c.config.Clock.Now().Add(ttl)
+}
+
+func (c *Cache[K, V]) expired(item *cacheItem[K, V]) bool {
+	return !item.expireAt.IsZero() && !c.config.Clock.Now().Before(item.expireAt)
+}
+
+// evict 淘汰元素直到再加入 count 个总成本为 cost 的元素后仍满足数量和成本的限制，调用方需持有锁。
+func (c *Cache[K, V]) evict(count int, cost int64) []evictedItem[K, V] {
+	evicted := []evictedItem[K, V]{}
+
+	for len(c.items) > 0 && c.overflow(count, cost) {
+		key, ok := c.policy.victim()
+		if !ok {
+			break
+		}
+
+		evicted = append(evicted, c.remove(c.items[key], EvictReasonCapacity))
+	}
+
+	return evicted
+}
+
+func (c *Cache[K, V]) overflow(count int, cost int64) bool {
+	return (c.config.Capacity > 0 && len(c.items)+count > c.config.Capacity) ||
+		(c.config.MaxCost > 0 && c.cost+cost > c.config.MaxCost)
+}
+
+// remove 删除元素并更新统计信息，调用方需持有锁。
+func (c *Cache[K, V]) remove(item *cacheItem[K, V], reason EvictReason) evictedItem[K, V] {
+	delete(c.items, item.key)
+	c.cost -= item.cost
+	c.policy.remove(item.key, reason == EvictReasonCapacity)
+
+	switch reason {
+	case EvictReasonCapacity:
+		c.stats.Evictions++
+	case EvictReasonExpired:
+		c.stats.Expirations++
+	}
+
+	return evictedItem[K, V]{key: item.key, value: item.value, reason: reason}
+}
+
+// notify 调用 OnEvict 回调，调用方不能持有锁。
+func (c *Cache[K, V]) notify(evicted []evictedItem[K, V]) {
+	if c.config.OnEvict == nil {
+		return
+	}
+
+	for _, item := range evicted {
+		c.config.OnEvict(item.key, item.value, item.reason)
+	}
+}
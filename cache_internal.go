@@ -0,0 +1,245 @@
+package kit
+
+// evictionPolicy 缓存的淘汰策略，只负责维护键的顺序，不保存值。
+type evictionPolicy[K comparable] interface {
+	// add 记录新加入缓存的键。
+	add(key K)
+	// access 记录键被访问。
+	access(key K)
+	// remove 删除键，evicted 表示键是否因容量不足被淘汰。
+	remove(key K, evicted bool)
+	// victim 返回下一个应当被淘汰的键。
+	victim() (K, bool)
+}
+
+func newEvictionPolicy[K comparable](policy EvictionPolicy, capacity int) evictionPolicy[K] {
+	switch policy {
+	case EvictLFU:
+		return newLFUPolicy[K]()
+	case EvictARC:
+		return newARCPolicy[K](capacity)
+	default:
+		return newLRUPolicy[K]()
+	}
+}
+
+// keyList 支持按键 O(1) 删除和移动的链表，链表头部为最近加入或访问的键。
+type keyList[K comparable] struct {
+	list  linkedList[K]
+	nodes map[K]*listNode[K]
+}
+
+func newKeyList[K comparable]() *keyList[K] {
+	return &keyList[K]{nodes: make(map[K]*listNode[K])}
+}
+
+func (l *keyList[K]) len() int {
+	return l.list.len()
+}
+
+func (l *keyList[K]) has(key K) bool {
+	_, ok := l.nodes[key]
+	return ok
+}
+
+func (l *keyList[K]) pushFront(key K) {
+	l.nodes[key] = l.list.pushFront(key)
+}
+
+func (l *keyList[K]) moveToFront(key K) {
+	if n, ok := l.nodes[key]; ok {
+		l.list.moveToFront(n)
+	}
+}
+
+func (l *keyList[K]) remove(key K) bool {
+	n, ok := l.nodes[key]
+	if ok {
+		l.list.remove(n)
+		delete(l.nodes, key)
+	}
+	return ok
+}
+
+// back 返回链表尾部，即最久未被访问的键。
+func (l *keyList[K]) back() (K, bool) {
+	if n := l.list.back(); n != nil {
+		return n.value, true
+	}
+	return Empty[K](), false
+}
+
+// lruPolicy 淘汰最久未被访问的键。
+type lruPolicy[K comparable] struct {
+	keys *keyList[K]
+}
+
+func newLRUPolicy[K comparable]() *lruPolicy[K] {
+	return &lruPolicy[K]{keys: newKeyList[K]()}
+}
+
+func (p *lruPolicy[K]) add(key K) {
+	p.keys.pushFront(key)
+}
+
+func (p *lruPolicy[K]) access(key K) {
+	p.keys.moveToFront(key)
+}
+
+func (p *lruPolicy[K]) remove(key K, _ bool) {
+	p.keys.remove(key)
+}
+
+func (p *lruPolicy[K]) victim() (K, bool) {
+	return p.keys.back()
+}
+
+// lfuPolicy 淘汰访问次数最少的键，访问次数相同时淘汰最久未被访问的键。
+type lfuPolicy[K comparable] struct {
+	freqs   map[K]int
+	buckets map[int]*keyList[K]
+	minFreq int
+}
+
+func newLFUPolicy[K comparable]() *lfuPolicy[K] {
+	return &lfuPolicy[K]{
+		freqs:   make(map[K]int),
+		buckets: make(map[int]*keyList[K]),
+	}
+}
+
+func (p *lfuPolicy[K]) add(key K) {
+	p.freqs[key] = 1
+	p.bucket(1).pushFront(key)
+	p.minFreq = 1
+}
+
+func (p *lfuPolicy[K]) access(key K) {
+	freq, ok := p.freqs[key]
+	if !ok {
+		return
+	}
+
+	p.detach(key, freq)
+	p.freqs[key] = freq + 1
+	p.bucket(freq + 1).pushFront(key)
+
+	if p.minFreq == freq && p.buckets[freq] == nil {
+		p.minFreq = freq + 1
+	}
+}
+
+func (p *lfuPolicy[K]) remove(key K, _ bool) {
+	if freq, ok := p.freqs[key]; ok {
+		p.detach(key, freq)
+		delete(p.freqs, key)
+	}
+}
+
+func (p *lfuPolicy[K]) victim() (K, bool) {
+	if len(p.freqs) == 0 {
+		return Empty[K](), false
+	}
+
+	if p.buckets[p.minFreq] == nil {
+		p.minFreq = Min(Keys(p.buckets)...)
+	}
+
+	return p.buckets[p.minFreq].back()
+}
+
+func (p *lfuPolicy[K]) bucket(freq int) *keyList[K] {
+	b, ok := p.buckets[freq]
+	if !ok {
+		b = newKeyList[K]()
+		p.buckets[freq] = b
+	}
+	return b
+}
+
+// detach 将键从访问次数对应的链表中删除，链表为空时一并删除。
+func (p *lfuPolicy[K]) detach(key K, freq int) {
+	b := p.buckets[freq]
+	b.remove(key)
+
+	if b.len() == 0 {
+		delete(p.buckets, freq)
+	}
+}
+
+// arcPolicy 自适应替换缓存（Adaptive Replacement Cache）。
+// t1 保存只被访问过一次的键，t2 保存被多次访问的键，
+// b1、b2 分别记录最近从 t1、t2 中淘汰的键，用于动态调整 t1 的目标大小 p。
+type arcPolicy[K comparable] struct {
+	capacity       int
+	p              int
+	t1, t2, b1, b2 *keyList[K]
+}
+
+func newARCPolicy[K comparable](capacity int) *arcPolicy[K] {
+	return &arcPolicy[K]{
+		capacity: capacity,
+		t1:       newKeyList[K](),
+		t2:       newKeyList[K](),
+		b1:       newKeyList[K](),
+		b2:       newKeyList[K](),
+	}
+}
+
+func (p *arcPolicy[K]) add(key K) {
+	switch {
+	case p.b1.remove(key):
+		// 命中 b1 说明 t1 过小，增大 p
+		p.p = Min(p.p+Max(p.b2.len()/Max(p.b1.len(), 1), 1), p.limit())
+		p.t2.pushFront(key)
+	case p.b2.remove(key):
+		// 命中 b2 说明 t2 过小，减小 p
+		p.p = Max(p.p-Max(p.b1.len()/Max(p.b2.len(), 1), 1), 0)
+		p.t2.pushFront(key)
+	default:
+		p.t1.pushFront(key)
+	}
+}
+
+func (p *arcPolicy[K]) access(key K) {
+	if p.t1.remove(key) {
+		p.t2.pushFront(key)
+		return
+	}
+	p.t2.moveToFront(key)
+}
+
+func (p *arcPolicy[K]) remove(key K, evicted bool) {
+	switch {
+	case p.t1.remove(key):
+		if evicted {
+			p.b1.pushFront(key)
+		}
+	case p.t2.remove(key):
+		if evicted {
+			p.b2.pushFront(key)
+		}
+	}
+
+	limit := p.limit()
+	for p.b1.len() > limit {
+		k, _ := p.b1.back()
+		p.b1.remove(k)
+	}
+	for p.b2.len() > limit {
+		k, _ := p.b2.back()
+		p.b2.remove(k)
+	}
+}
+
+func (p *arcPolicy[K]) victim() (K, bool) {
+	if p.t1.len() > 0 && (p.t1.len() > p.p || p.t2.len() == 0) {
+		return p.t1.back()
+	}
+	return p.t2.back()
+}
+
+// limit 返回 p 和幽灵链表的上限，未限制容量时使用当前缓存的大小。
+func (p *arcPolicy[K]) limit() int {
+	return Max(p.capacity, p.t1.len()+p.t2.len(), 1)
+}
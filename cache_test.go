@@ -0,0 +1,312 @@
+package kit
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheLRU(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c := NewCache(CacheConfig[string, int]{Capacity: 2})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+
+	is.Equal(2, c.Len())
+	is.True(c.Has("a"))
+	is.False(c.Has("b"))
+	is.True(c.Has("c"))
+
+	// 更新已存在的键也视为访问
+	c.Set("a", 10)
+	c.Set("d", 4)
+
+	v, ok := c.Get("a")
+	is.True(ok)
+	is.Equal(10, v)
+	is.False(c.Has("c"))
+}
+
+func TestCacheLFU(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c := NewCache(CacheConfig[string, int]{Policy: EvictLFU, Capacity: 3})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Get("c")
+
+	// b、c 的访问次数相同，淘汰最久未被访问的 b
+	c.Set("d", 4)
+	is.ElementsMatch([]string{"a", "c", "d"}, c.Keys())
+
+	// 新加入的 d 访问次数最少
+	c.Set("e", 5)
+	is.ElementsMatch([]string{"a", "c", "e"}, c.Keys())
+
+	c.Delete("e")
+	c.Delete("c")
+	c.Set("f", 6)
+	c.Set("g", 7)
+	c.Set("h", 8)
+	is.ElementsMatch([]string{"a", "g", "h"}, c.Keys())
+}
+
+func TestCacheARC(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c := NewCache(CacheConfig[int, int]{Policy: EvictARC, Capacity: 4})
+
+	// 频繁访问的热点数据
+	for i := 0; i < 2; i++ {
+		c.Set(i, i)
+		c.Get(i)
+	}
+
+	// 大量只访问一次的数据扫描不会淘汰热点数据
+	for i := 100; i < 200; i++ {
+		c.Set(i, i)
+	}
+
+	is.Equal(4, c.Len())
+	is.True(c.Has(0))
+	is.True(c.Has(1))
+
+	// 刚被淘汰的键再次加入时视为多次访问，不会被后续的扫描淘汰
+	c.Set(197, 197)
+	c.Set(300, 300)
+	c.Set(301, 301)
+	is.True(c.Has(197))
+	is.Equal(4, c.Len())
+
+	for i := 0; i < 1000; i++ {
+		c.Set(i%37, i)
+		c.Get((i * 7) % 37)
+		is.LessOrEqual(c.Len(), 4)
+	}
+}
+
+func TestCacheCost(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c := NewCache(CacheConfig[string, string]{
+		MaxCost: 10,
+		Cost: func(key string, value string) int64 {
+			return int64(len(value))
+		},
+	})
+
+	c.Set("a", "12345")
+	c.Set("b", "1234")
+	is.Equal(int64(9), c.Cost())
+
+	c.Set("c", "123")
+	is.Equal(int64(7), c.Cost())
+	is.ElementsMatch([]string{"b", "c"}, c.Keys())
+
+	c.Set("b", "1")
+	is.Equal(int64(4), c.Cost())
+
+	// 超出上限的元素会被立即淘汰
+	c.Set("d", "12345678901")
+	is.Equal(int64(0), c.Cost())
+	is.Equal(0, c.Len())
+}
+
+func TestCacheTTL(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	evicted := []string{}
+
+	c := NewCache(CacheConfig[string, int]{
+		TTL:   time.Minute,
+		Clock: clock,
+		OnEvict: func(key string, value int, reason EvictReason) {
+			is.Equal(EvictReasonExpired, reason)
+			evicted = append(evicted, key)
+		},
+	})
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 2*time.Minute)
+	c.SetWithTTL("c", 3, 0)
+
+	ttl, ok := c.TTL("a")
+	is.True(ok)
+	is.Equal(time.Minute, ttl)
+
+	ttl, ok = c.TTL("c")
+	is.True(ok)
+	is.Equal(time.Duration(0), ttl)
+
+	clock.Advance(time.Minute)
+
+	_, ok = c.Get("a")
+	is.False(ok)
+	_, ok = c.TTL("a")
+	is.False(ok)
+	is.Equal([]string{"a"}, evicted)
+
+	is.True(c.Has("b"))
+	is.ElementsMatch([]string{"b", "c"}, c.Keys())
+
+	clock.Advance(time.Hour)
+
+	is.False(c.Has("b"))
+	is.Equal(2, c.Len())
+	is.Equal(1, c.DeleteExpired())
+	is.Equal([]string{"a", "b"}, evicted)
+	is.Equal([]string{"c"}, c.Keys())
+
+	stats := c.Stats()
+	is.Equal(uint64(2), stats.Expirations)
+	is.Equal(uint64(1), stats.Misses)
+}
+
+func TestCacheTouchAndSnapshot(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Now())
+	evicted := []EvictReason{}
+
+	c := NewCache(CacheConfig[string, int]{
+		TTL:   time.Minute,
+		Clock: clock,
+		OnEvict: func(key string, value int, reason EvictReason) {
+			evicted = append(evicted, reason)
+		},
+	})
+
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, time.Hour)
+	c.SetWithTTL("c", 3, 0)
+
+	clock.Advance(30 * time.Second)
+	is.True(c.Touch("a"))
+	is.True(c.Touch("c"))
+	is.False(c.Touch("d"))
+
+	ttl, _ := c.TTL("a")
+	is.Equal(time.Minute, ttl)
+	ttl, _ = c.TTL("c")
+	is.Equal(time.Duration(0), ttl)
+
+	clock.Advance(time.Minute)
+	is.False(c.Touch("a"))
+	is.Equal(map[string]int{"b": 2, "c": 3}, c.Snapshot())
+
+	is.False(c.Delete("a"))
+	is.True(c.Delete("b"))
+	is.Equal([]EvictReason{EvictReasonExpired, EvictReasonDeleted}, evicted)
+	is.Equal(CacheStats{Expirations: 1}, c.Stats())
+}
+
+func TestCacheBackgroundCleanup(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Now())
+
+	c := NewCache(CacheConfig[string, int]{
+		TTL:             time.Minute,
+		CleanupInterval: time.Millisecond,
+		Clock:           clock,
+	})
+	defer c.Close()
+
+	c.Set("a", 1)
+	clock.Advance(time.Minute)
+
+	is.Eventually(func() bool {
+		return c.Len() == 0
+	}, time.Second, time.Millisecond)
+
+	c.Close()
+	c.Close()
+}
+
+func TestCacheStatsAndEvict(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type evict struct {
+		key    int
+		reason EvictReason
+	}
+	evicted := []evict{}
+
+	c := NewCache(CacheConfig[int, int]{
+		Capacity: 2,
+		OnEvict: func(key int, value int, reason EvictReason) {
+			evicted = append(evicted, evict{key, reason})
+		},
+	})
+
+	c.Set(1, 1)
+	c.Set(2, 2)
+	c.Set(3, 3)
+	c.Get(2)
+	c.Get(1)
+	c.Peek(3)
+	is.True(c.Delete(3))
+	is.False(c.Delete(3))
+
+	is.Equal([]evict{{1, EvictReasonCapacity}, {3, EvictReasonDeleted}}, evicted)
+
+	stats := c.Stats()
+	is.Equal(CacheStats{Hits: 1, Misses: 1, Evictions: 1}, stats)
+	is.Equal(0.5, stats.HitRate())
+	is.Equal(float64(0), CacheStats{}.HitRate())
+
+	c.Purge()
+	is.Equal(0, c.Len())
+	is.Equal(int64(0), c.Cost())
+	is.Len(evicted, 2)
+}
+
+func TestCacheConcurrent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, policy := range []EvictionPolicy{EvictLRU, EvictLFU, EvictARC} {
+		c := NewCache(CacheConfig[int, int]{Policy: policy, Capacity: 50})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				for j := 0; j < 500; j++ {
+					c.Set(i*j%100, j)
+					c.Get(j % 100)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		keys := c.Keys()
+		sort.Ints(keys)
+
+		is.Equal(50, c.Len())
+		is.Equal(int64(50), c.Cost())
+		is.Len(Unique(keys), 50)
+	}
+}
@@ -0,0 +1,54 @@
+package kit
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock 时钟接口，用于在需要依赖当前时间的组件中注入时间来源。
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock 使用系统时间的时钟。
+type SystemClock struct{}
+
+// Now 返回当前的系统时间。
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ManualClock 手动控制的时钟，只有调用 Set 或 Advance 时时间才会变化，适用于测试。
+type ManualClock struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewManualClock 创建以 now 为当前时间的手动时钟。
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{now: now}
+}
+
+// Now 返回时钟的当前时间。
+func (c *ManualClock) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.now
+}
+
+// Set 将时钟设置为给定的时间。
+func (c *ManualClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
+
+// Advance 将时钟向后拨动 d。
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,27 @@
+package kit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManualClock(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewManualClock(now)
+
+	is.Equal(now, clock.Now())
+
+	clock.Advance(time.Hour)
+	is.Equal(now.Add(time.Hour), clock.Now())
+
+	clock.Set(now)
+	is.Equal(now, clock.Now())
+
+	var _ Clock = SystemClock{}
+	is.WithinDuration(time.Now(), SystemClock{}.Now(), time.Second)
+}
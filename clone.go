@@ -0,0 +1,190 @@
+package kit
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// DeepClone 使用反射返回 v 的深拷贝。
+//
+// map、切片、数组、指针、接口和结构体会被递归复制，结构体的未导出字段同样会被复制。
+// 指向同一地址的指针、同一个 map 以及共享底层数组的相同切片在拷贝后仍然共享，因此循环引用也能被正确复制。
+// 类型实现了 Clonable 时（Clone 方法返回自身类型）直接调用 Clone 复制，Clone 的实现中不能再对同一类型调用 DeepClone。
+// 函数、通道和 unsafe.Pointer 不会被复制，拷贝与原值引用同一个对象；map 的键也不会被复制。
+// time.Time、time.Location、reflect.Value 以及 sync 和 sync/atomic 包中的类型不会递归复制内部字段，
+// 而是像赋值一样浅拷贝，指向这些类型的指针直接共享；其中 sync.Mutex 和 sync.RWMutex 在拷贝中为未加锁的零值。
+func DeepClone[T any](v T) T {
+	c := cloner{visited: make(map[cloneKey]reflect.Value)}
+
+	var result T
+	if cloned := c.clone(reflect.ValueOf(&v).Elem()); cloned.IsValid() {
+		reflect.ValueOf(&result).Elem().Set(cloned)
+	}
+
+	return result
+}
+
+var (
+	mutexType   = reflect.TypeOf((*sync.Mutex)(nil)).Elem()
+	rwMutexType = reflect.TypeOf((*sync.RWMutex)(nil)).Elem()
+)
+
+type cloner struct {
+	visited map[cloneKey]reflect.Value
+}
+
+// cloneKey 标识已经复制过的引用类型的值。
+type cloneKey struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+	len int
+	cap int
+}
+
+// clone 返回 v 的深拷贝，返回值的类型与 v 相同。
+func (c *cloner) clone(v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+	}
+
+	if opaqueType(v.Type()) {
+		return cloneOpaque(v)
+	}
+	if v.Kind() == reflect.Pointer && opaqueType(v.Type().Elem()) {
+		return v
+	}
+
+	var key cloneKey
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map:
+		key = cloneKey{ptr: v.UnsafePointer(), typ: v.Type()}
+	case reflect.Slice:
+		key = cloneKey{ptr: v.UnsafePointer(), typ: v.Type(), len: v.Len(), cap: v.Cap()}
+	}
+
+	if key.typ != nil {
+		if cloned, ok := c.visited[key]; ok {
+			return cloned
+		}
+	}
+
+	if cloned, ok := cloneByMethod(v); ok {
+		if key.typ != nil {
+			c.visited[key] = cloned
+		}
+		return cloned
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		result := reflect.New(v.Type().Elem())
+		c.visited[key] = result
+		result.Elem().Set(c.clone(v.Elem()))
+		return result
+	case reflect.Map:
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.visited[key] = result
+
+		iter := v.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), c.clone(iter.Value()))
+		}
+		return result
+	case reflect.Slice:
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		c.visited[key] = result
+
+		for i := 0; i < v.Len(); i++ {
+			result.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return result
+	case reflect.Array:
+		result := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			result.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return result
+	case reflect.Interface:
+		result := reflect.New(v.Type()).Elem()
+		result.Set(c.clone(v.Elem()))
+		return result
+	case reflect.Struct:
+		return c.cloneStruct(v)
+	default:
+		return v
+	}
+}
+
+func (c *cloner) cloneStruct(v reflect.Value) reflect.Value {
+	// 未导出字段需要通过地址访问，不可寻址时先复制一份
+	src := v
+	if !src.CanAddr() {
+		src = reflect.New(v.Type()).Elem()
+		src.Set(v)
+	}
+
+	result := reflect.New(v.Type()).Elem()
+	result.Set(src)
+
+	for i := 0; i < v.NumField(); i++ {
+		settableField(result, i).Set(c.clone(settableField(src, i)))
+	}
+
+	return result
+}
+
+// settableField 返回可寻址结构体 v 的第 i 个字段，未导出的字段也可以读写。
+func settableField(v reflect.Value, i int) reflect.Value {
+	field := v.Field(i)
+	if field.CanSet() {
+		return field
+	}
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+// opaqueType 判断 t 是否是不能递归复制内部字段的类型。
+// 这些类型的未导出字段保存着锁状态、时区缓存或运行时指针，逐字段复制会破坏它们的语义。
+func opaqueType(t reflect.Type) bool {
+	switch t.PkgPath() {
+	case "sync", "sync/atomic":
+		return true
+	case "time":
+		return t.Name() == "Time" || t.Name() == "Location"
+	case "reflect":
+		return t.Name() == "Value"
+	default:
+		return false
+	}
+}
+
+// cloneOpaque 浅拷贝 opaqueType 类型的值，锁总是以未加锁的零值复制。
+func cloneOpaque(v reflect.Value) reflect.Value {
+	switch v.Type() {
+	case mutexType, rwMutexType:
+		return reflect.New(v.Type()).Elem()
+	default:
+		return v
+	}
+}
+
+// cloneByMethod 当 v 的类型实现了 Clonable 时调用 Clone 方法复制 v。
+func cloneByMethod(v reflect.Value) (reflect.Value, bool) {
+	if v.Kind() == reflect.Interface {
+		return reflect.Value{}, false
+	}
+
+	method, ok := v.Type().MethodByName("Clone")
+	if !ok || method.Type.NumIn() != 1 || method.Type.NumOut() != 1 || method.Type.Out(0) != v.Type() {
+		return reflect.Value{}, false
+	}
+
+	return v.Method(method.Index).Call(nil)[0], true
+}
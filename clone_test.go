@@ -0,0 +1,179 @@
+package kit
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cloneNode struct {
+	Value    int
+	Next     *cloneNode
+	children []*cloneNode
+	meta     map[string]any
+}
+
+type cloneGuarded struct {
+	mu      sync.Mutex
+	rw      sync.RWMutex
+	hits    atomic.Int64
+	created time.Time
+	loc     *time.Location
+	items   []int
+}
+
+type cloneCounter struct {
+	calls *int
+}
+
+func (c cloneCounter) Clone() cloneCounter {
+	*c.calls++
+	return cloneCounter{calls: c.calls}
+}
+
+func TestDeepClone(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := DeepClone(42)
+	result2 := DeepClone("foo")
+	result3 := DeepClone([]int(nil))
+	result4 := DeepClone[any](nil)
+	result5 := DeepClone(map[string][]int{"a": {1, 2}})
+	result6 := DeepClone([2][]int{{1}, {2}})
+
+	is.Equal(42, result1)
+	is.Equal("foo", result2)
+	is.Nil(result3)
+	is.Nil(result4)
+	is.Equal(map[string][]int{"a": {1, 2}}, result5)
+	is.Equal([2][]int{{1}, {2}}, result6)
+
+	data := map[string]any{
+		"list": []any{1, map[string]int{"a": 1}},
+		"ptr":  &cloneNode{Value: 1},
+	}
+	cloned := DeepClone(data)
+
+	is.Equal(data, cloned)
+	cloned["list"].([]any)[1].(map[string]int)["a"] = 2
+	cloned["ptr"].(*cloneNode).Value = 2
+	is.Equal(1, data["list"].([]any)[1].(map[string]int)["a"])
+	is.Equal(1, data["ptr"].(*cloneNode).Value)
+}
+
+func TestDeepCloneUnexported(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	child := &cloneNode{Value: 2}
+	node := cloneNode{
+		Value:    1,
+		children: []*cloneNode{child},
+		meta:     map[string]any{"tags": []string{"a"}},
+	}
+
+	cloned := DeepClone(node)
+
+	is.Equal(node, cloned)
+	is.NotSame(node.children[0], cloned.children[0])
+
+	cloned.children[0].Value = 3
+	cloned.meta["tags"].([]string)[0] = "b"
+	is.Equal(2, child.Value)
+	is.Equal([]string{"a"}, node.meta["tags"])
+}
+
+func TestDeepCloneAliasing(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// 循环引用
+	a := &cloneNode{Value: 1}
+	b := &cloneNode{Value: 2, Next: a}
+	a.Next = b
+
+	clonedA := DeepClone(a)
+	is.NotSame(a, clonedA)
+	is.Same(clonedA, clonedA.Next.Next)
+	is.Equal(2, clonedA.Next.Value)
+
+	// 共享的指针、map 和切片
+	shared := &cloneNode{Value: 1}
+	sharedMap := map[string]any{}
+	sharedMap["self"] = sharedMap
+	sharedSlice := []int{1, 2}
+
+	value := struct {
+		A, B   *cloneNode
+		M      map[string]any
+		S1, S2 []int
+	}{shared, shared, sharedMap, sharedSlice, sharedSlice}
+
+	cloned := DeepClone(value)
+	is.Same(cloned.A, cloned.B)
+	is.NotSame(shared, cloned.A)
+
+	cloned.M["foo"] = "bar"
+	is.Equal("bar", cloned.M["self"].(map[string]any)["foo"])
+	is.NotContains(sharedMap, "foo")
+
+	cloned.S1[0] = 3
+	is.Equal([]int{3, 2}, cloned.S2)
+	is.Equal([]int{1, 2}, sharedSlice)
+}
+
+func TestDeepCloneClonable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	calls := 0
+	counter := cloneCounter{calls: &calls}
+
+	DeepClone(counter)
+	DeepClone([]cloneCounter{counter, counter})
+	DeepClone(map[string]any{"counter": counter})
+
+	is.Equal(4, calls)
+	is.Equal(foo{"a"}, DeepClone(foo{"a"}))
+}
+
+func TestDeepCloneOpaque(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	now := time.Now().In(loc)
+
+	is.True(DeepClone(now) == now)
+	for _, item := range Fill(make([]time.Time, 2), now) {
+		is.True(item == now)
+	}
+	for _, item := range Repeat(2, map[string]time.Time{"now": now}) {
+		is.True(item["now"] == now)
+	}
+
+	guarded := &cloneGuarded{created: now, loc: loc, items: []int{1}}
+	guarded.mu.Lock()
+	guarded.rw.RLock()
+	guarded.hits.Store(3)
+
+	cloned := DeepClone(guarded)
+
+	is.True(cloned.mu.TryLock())
+	is.True(cloned.rw.TryLock())
+	is.False(guarded.mu.TryLock())
+	is.Equal(int64(3), cloned.hits.Load())
+	is.True(cloned.created == now)
+	is.Same(loc, cloned.loc)
+
+	cloned.items[0] = 2
+	is.Equal([]int{1}, guarded.items)
+
+	value := reflect.ValueOf(guarded)
+	is.Equal(value.Pointer(), DeepClone(value).Pointer())
+}
@@ -0,0 +1,332 @@
+package kit
+
+import (
+	"math"
+	"reflect"
+	"sync"
+)
+
+// defaultShardCount ConcurrentMap 默认的分片数量。
+const defaultShardCount = 32
+
+// ConcurrentMap 分片加锁的并发安全映射，不同分片上的读写互不阻塞。
+type ConcurrentMap[K comparable, V any] struct {
+	shards []*mapShard[K, V]
+	mask   uint64
+}
+
+type mapShard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	items map[K]V
+}
+
+// NewConcurrentMap 创建并发安全的映射。可以指定分片数量，会被向上取整为 2 的幂，默认为 32。
+func NewConcurrentMap[K comparable, V any](shardCount ...int) *ConcurrentMap[K, V] {
+	count := defaultShardCount
+	if len(shardCount) > 0 && shardCount[0] > 0 {
+		count = shardCount[0]
+	}
+
+	size := 1
+	for size < count {
+		size <<= 1
+	}
+
+	m := &ConcurrentMap[K, V]{
+		shards: make([]*mapShard[K, V], size),
+		mask:   uint64(size - 1),
+	}
+
+	for i := range m.shards {
+		m.shards[i] = &mapShard[K, V]{items: make(map[K]V)}
+	}
+
+	return m
+}
+
+// Get 返回给定键的值，键不存在时返回零值和 false。
+func (m *ConcurrentMap[K, V]) Get(key K) (V, bool) {
+	shard := m.shard(key)
+
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	v, ok := shard.items[key]
+	return v, ok
+}
+
+// Has 判断键是否存在。
+func (m *ConcurrentMap[K, V]) Has(key K) bool {
+	_, ok := m.Get(key)
+	return ok
+}
+
+// Set 设置键值对。
+func (m *ConcurrentMap[K, V]) Set(key K, value V) {
+	shard := m.shard(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	shard.items[key] = value
+}
+
+// SetIfAbsent 当键不存在时设置键值对，返回是否设置成功。
+func (m *ConcurrentMap[K, V]) SetIfAbsent(key K, value V) bool {
+	shard := m.shard(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if _, ok := shard.items[key]; ok {
+		return false
+	}
+
+	shard.items[key] = value
+	return true
+}
+
+// Delete 删除给定的键，返回键是否存在。
+func (m *ConcurrentMap[K, V]) Delete(key K) bool {
+	_, ok := m.Pop(key)
+	return ok
+}
+
+// Pop 删除并返回给定键的值，键不存在时返回零值和 false。
+func (m *ConcurrentMap[K, V]) Pop(key K) (V, bool) {
+	shard := m.shard(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	v, ok := shard.items[key]
+	delete(shard.items, key)
+
+	return v, ok
+}
+
+// GetOrCompute 返回给定键的值，键不存在时使用 compute 函数计算值并保存。
+// 第二个返回值表示值是否已经存在。compute 函数在持有分片锁时调用，同一个键只会计算一次。
+func (m *ConcurrentMap[K, V]) GetOrCompute(key K, compute func() V) (V, bool) {
+	shard := m.shard(key)
+
+	shard.mu.RLock()
+	v, ok := shard.items[key]
+	shard.mu.RUnlock()
+
+	if ok {
+		return v, true
+	}
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	if v, ok := shard.items[key]; ok {
+		return v, true
+	}
+
+	v = compute()
+	shard.items[key] = v
+
+	return v, false
+}
+
+// Compute 使用 compute 函数原子地更新给定键的值并返回新值。
+// compute 函数接收旧值和键是否存在，返回新值和是否保留，不保留时会删除该键。
+// compute 函数在持有分片锁时调用，不能在其中访问同一个映射。
+func (m *ConcurrentMap[K, V]) Compute(key K, compute func(value V, exist bool) (V, bool)) V {
+	shard := m.shard(key)
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	old, exist := shard.items[key]
+	v, keep := compute(old, exist)
+
+	if keep {
+		shard.items[key] = v
+	} else {
+		delete(shard.items, key)
+	}
+
+	return v
+}
+
+// Len 返回键值对的个数。
+func (m *ConcurrentMap[K, V]) Len() int {
+	count := 0
+
+	for _, shard := range m.shards {
+		shard.mu.RLock()
+		count += len(shard.items)
+		shard.mu.RUnlock()
+	}
+
+	return count
+}
+
+// Range 遍历所有键值对，当 iteratee 返回 false 时停止遍历。
+// 遍历每个分片时持有该分片的读锁，不能在 iteratee 中修改映射。
+func (m *ConcurrentMap[K, V]) Range(iteratee func(key K, value V) bool) {
+	for _, shard := range m.shards {
+		if !shard.rangeItems(iteratee) {
+			return
+		}
+	}
+}
+
+// Keys 返回所有的键，顺序是随机的。
+func (m *ConcurrentMap[K, V]) Keys() []K {
+	return Keys(m.Snapshot())
+}
+
+// Snapshot 返回映射当前状态的拷贝，可以配合 map 相关的函数使用。
+// 各个分片依次加锁拷贝，不保证整体的一致性。
+func (m *ConcurrentMap[K, V]) Snapshot() map[K]V {
+	result := make(map[K]V)
+
+	m.Range(func(key K, value V) bool {
+		result[key] = value
+		return true
+	})
+
+	return result
+}
+
+// Clear 清空映射。
+func (m *ConcurrentMap[K, V]) Clear() {
+	for _, shard := range m.shards {
+		shard.mu.Lock()
+		shard.items = make(map[K]V)
+		shard.mu.Unlock()
+	}
+}
+
+func (m *ConcurrentMap[K, V]) shard(key K) *mapShard[K, V] {
+	return m.shards[hashKey(key)&m.mask]
+}
+
+func (s *mapShard[K, V]) rangeItems(iteratee func(key K, value V) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for k, v := range s.items {
+		if !iteratee(k, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hashKey 计算键的哈希值。
+// 字符串和数值类型直接计算，其他类型通过反射遍历其值计算，相等的键总是得到相同的哈希值。
+func hashKey[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return hashString(k)
+	case int:
+		return mix64(uint64(k))
+	case int8:
+		return mix64(uint64(k))
+	case int16:
+		return mix64(uint64(k))
+	case int32:
+		return mix64(uint64(k))
+	case int64:
+		return mix64(uint64(k))
+	case uint:
+		return mix64(uint64(k))
+	case uint8:
+		return mix64(uint64(k))
+	case uint16:
+		return mix64(uint64(k))
+	case uint32:
+		return mix64(uint64(k))
+	case uint64:
+		return mix64(k)
+	case uintptr:
+		return mix64(uint64(k))
+	case float32:
+		return mix64(floatBits(float64(k)))
+	case float64:
+		return mix64(floatBits(k))
+	default:
+		return hashValue(hashSeed, reflect.ValueOf(key))
+	}
+}
+
+// hashSeed 反射计算哈希值时的初始值。
+const hashSeed = uint64(14695981039346656037)
+
+// hashValue 将 v 的值合并到哈希值 h 中。
+// 与 == 的语义保持一致：结构体和数组逐个字段计算，指针和通道按地址计算，接口按其动态值计算。
+func hashValue(h uint64, v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return mix64(h)
+	case reflect.String:
+		return mix64(h ^ hashString(v.String()))
+	case reflect.Bool:
+		return mix64(h ^ uint64(Ternary(v.Bool(), 1, 0)))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix64(h ^ uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix64(h ^ v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return mix64(h ^ floatBits(v.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return mix64(mix64(h^floatBits(real(c))) ^ floatBits(imag(c)))
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return mix64(h ^ uint64(v.Pointer()))
+	case reflect.Interface:
+		return hashValue(h, v.Elem())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			h = hashValue(h, v.Index(i))
+		}
+		return h
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			// == 会忽略空白字段
+			if v.Type().Field(i).Name != "_" {
+				h = hashValue(h, v.Field(i))
+			}
+		}
+		return mix64(h)
+	default:
+		// 可比较的类型不会出现在这里
+		return h
+	}
+}
+
+// floatBits 返回浮点数的二进制表示，+0 和 -0 相等，需要得到相同的结果。
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// hashString FNV-1a 哈希
+func hashString(s string) uint64 {
+	hash := uint64(14695981039346656037)
+
+	for i := 0; i < len(s); i++ {
+		hash ^= uint64(s[i])
+		hash *= 1099511628211
+	}
+
+	return hash
+}
+
+// mix64 splitmix64 的混合函数，使相邻的整数分布到不同的分片。
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
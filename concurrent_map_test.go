@@ -0,0 +1,252 @@
+package kit
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewConcurrentMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	v, ok := m.Get("a")
+	is.True(ok)
+	is.Equal(1, v)
+
+	_, ok = m.Get("c")
+	is.False(ok)
+
+	is.True(m.Has("b"))
+	is.False(m.SetIfAbsent("b", 20))
+	is.True(m.SetIfAbsent("c", 3))
+	is.Equal(3, m.Len())
+
+	v, ok = m.Pop("c")
+	is.True(ok)
+	is.Equal(3, v)
+	is.True(m.Delete("b"))
+	is.False(m.Delete("b"))
+
+	is.Equal(map[string]int{"a": 1}, m.Snapshot())
+	is.Equal([]string{"a"}, m.Keys())
+
+	m.Clear()
+	is.Equal(0, m.Len())
+
+	is.Len(NewConcurrentMap[int, int](5).shards, 8)
+	is.Len(NewConcurrentMap[int, int](0).shards, defaultShardCount)
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewConcurrentMap[string, int]()
+
+	v, loaded := m.GetOrCompute("a", func() int { return 1 })
+	is.Equal(1, v)
+	is.False(loaded)
+
+	v, loaded = m.GetOrCompute("a", func() int { return 2 })
+	is.Equal(1, v)
+	is.True(loaded)
+
+	increase := func(value int, exist bool) (int, bool) {
+		return value + 1, true
+	}
+
+	is.Equal(2, m.Compute("a", increase))
+	is.Equal(1, m.Compute("b", increase))
+
+	m.Compute("a", func(value int, exist bool) (int, bool) {
+		is.True(exist)
+		return 0, false
+	})
+	is.False(m.Has("a"))
+
+	// 与 map.go 中的函数配合使用
+	is.Equal(map[string]int{"b": 1}, PickBy(m.Snapshot(), func(key string, value int) bool {
+		return value > 0
+	}))
+}
+
+func TestConcurrentMapRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewConcurrentMap[int, int](4)
+	for i := 0; i < 100; i++ {
+		m.Set(i, i*i)
+	}
+
+	keys := []int{}
+	m.Range(func(key int, value int) bool {
+		is.Equal(key*key, value)
+		keys = append(keys, key)
+		return true
+	})
+	sort.Ints(keys)
+	is.Equal(Range(100), keys)
+
+	count := 0
+	m.Range(func(key int, value int) bool {
+		count++
+		return count < 10
+	})
+	is.Equal(10, count)
+}
+
+func TestConcurrentMapParallel(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewConcurrentMap[int, int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				m.Compute(j%100, func(value int, _ bool) (int, bool) {
+					return value + 1, true
+				})
+				m.GetOrCompute(j, func() int { return j })
+				m.Get(j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	is.Equal(1000, m.Len())
+	for i := 0; i < 100; i++ {
+		v, _ := m.Get(i)
+		is.GreaterOrEqual(v, 80)
+	}
+}
+
+func TestHashKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type point struct {
+		x, y int
+	}
+
+	is.Equal(hashKey("foo"), hashKey("foo"))
+	is.NotEqual(hashKey("foo"), hashKey("bar"))
+	is.Equal(hashKey(point{1, 2}), hashKey(point{1, 2}))
+	is.Equal(hashKey(0.0), hashKey(math.Copysign(0, -1)))
+	is.Equal(hashKey[any]("foo"), hashKey("foo"))
+	is.Equal(hashKey(struct{ F float64 }{0}), hashKey(struct{ F float64 }{math.Copysign(0, -1)}))
+	is.Equal(hashKey([2]any{"a", 1}), hashKey([2]any{"a", 1}))
+	is.NotEqual(hashKey(point{1, 2}), hashKey(point{2, 1}))
+}
+
+type concurrentMapStringerKey struct {
+	name string
+}
+
+func (k *concurrentMapStringerKey) String() string {
+	return k.name
+}
+
+func TestConcurrentMapStructKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type floatKey struct {
+		F float64
+	}
+
+	m := NewConcurrentMap[floatKey, int]()
+	m.Set(floatKey{0}, 1)
+
+	v, ok := m.Get(floatKey{math.Copysign(0, -1)})
+	is.True(ok)
+	is.Equal(1, v)
+
+	// 指针键按地址计算哈希值，修改指向的值或者实现了 String 方法都不影响查找
+	keys := make([]*concurrentMapStringerKey, 100)
+	p := NewConcurrentMap[*concurrentMapStringerKey, int]()
+	for i := range keys {
+		keys[i] = &concurrentMapStringerKey{name: strconv.Itoa(i)}
+		p.Set(keys[i], i)
+	}
+	for i, key := range keys {
+		key.name = "changed"
+		v, ok := p.Get(key)
+		is.True(ok)
+		is.Equal(i, v)
+	}
+}
+
+func BenchmarkConcurrentMap(b *testing.B) {
+	keys := RepeatBy(1024, strconv.Itoa)
+
+	b.Run("ConcurrentMap", func(b *testing.B) {
+		m := NewConcurrentMap[string, int]()
+
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				key := keys[i%len(keys)]
+				if i%4 == 0 {
+					m.Set(key, i)
+				} else {
+					m.Get(key)
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("SyncMap", func(b *testing.B) {
+		var m sync.Map
+
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				key := keys[i%len(keys)]
+				if i%4 == 0 {
+					m.Store(key, i)
+				} else {
+					m.Load(key)
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("MutexMap", func(b *testing.B) {
+		var mu sync.RWMutex
+		m := map[string]int{}
+
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				key := keys[i%len(keys)]
+				if i%4 == 0 {
+					mu.Lock()
+					m[key] = i
+					mu.Unlock()
+				} else {
+					mu.RLock()
+					_ = m[key]
+					mu.RUnlock()
+				}
+				i++
+			}
+		})
+	})
+}
@@ -0,0 +1,159 @@
+package kit
+
+import "sort"
+
+// Counter 统计元素出现次数的计数器。零值可以直接使用。
+//
+// 计数可以为负数，计数变为 0 的元素会被删除。
+type Counter[K comparable] struct {
+	items map[K]*counterItem
+	seq   uint64
+}
+
+type counterItem struct {
+	count int
+	// seq 元素第一次出现的顺序，用于在计数相同时保持稳定的顺序
+	seq uint64
+}
+
+// NewCounter 使用给定的元素创建计数器，每个元素计数一次。
+func NewCounter[K comparable](items ...K) *Counter[K] {
+	c := &Counter[K]{}
+	for _, item := range items {
+		c.Inc(item)
+	}
+	return c
+}
+
+// NewCounterFromMap 使用元素到计数的映射创建计数器，可以直接使用 CountValues 或 CountByKey 的结果。
+// map 的遍历顺序是随机的，因此计数相同的元素在 MostCommon 中的顺序不确定，需要稳定顺序时使用 NewCounterFromEntries。
+func NewCounterFromMap[K comparable](counts map[K]int) *Counter[K] {
+	c := &Counter[K]{}
+	for key, count := range counts {
+		c.Add(key, count)
+	}
+	return c
+}
+
+// NewCounterFromEntries 使用元素及其计数的列表创建计数器，元素按列表中的顺序视为出现的先后顺序。
+// 同一个元素出现多次时计数会累加。
+func NewCounterFromEntries[K comparable](entries []Entry[K, int]) *Counter[K] {
+	c := &Counter[K]{}
+	for _, entry := range entries {
+		c.Add(entry.Key, entry.Value)
+	}
+	return c
+}
+
+// Add 将元素的计数增加 n，n 可以为负数。返回增加后的计数。
+func (c *Counter[K]) Add(key K, n int) int {
+	if c.items == nil {
+		c.items = make(map[K]*counterItem)
+	}
+
+	item, ok := c.items[key]
+	if !ok {
+		c.seq++
+		item = &counterItem{seq: c.seq}
+		c.items[key] = item
+	}
+
+	item.count += n
+	if item.count == 0 {
+		delete(c.items, key)
+	}
+
+	return item.count
+}
+
+// Inc 将元素的计数增加 1，返回增加后的计数。
+func (c *Counter[K]) Inc(key K) int {
+	return c.Add(key, 1)
+}
+
+// Get 返回元素的计数，元素不存在时返回 0。
+func (c *Counter[K]) Get(key K) int {
+	if item, ok := c.items[key]; ok {
+		return item.count
+	}
+	return 0
+}
+
+// Delete 删除元素的计数，返回元素是否存在。
+func (c *Counter[K]) Delete(key K) bool {
+	_, ok := c.items[key]
+	delete(c.items, key)
+	return ok
+}
+
+// Len 返回计数不为 0 的元素个数。
+func (c *Counter[K]) Len() int {
+	return len(c.items)
+}
+
+// Total 返回所有元素的计数之和。
+func (c *Counter[K]) Total() int {
+	total := 0
+	for _, item := range c.items {
+		total += item.count
+	}
+	return total
+}
+
+// MostCommon 返回计数最多的 n 个元素及其计数，按计数从大到小排列，计数相同时先加入计数器的元素在前。
+// n 小于 0 时返回所有元素。
+func (c *Counter[K]) MostCommon(n int) []Entry[K, int] {
+	keys := Keys(c.items)
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := c.items[keys[i]], c.items[keys[j]]
+		if a.count != b.count {
+			return a.count > b.count
+		}
+		return a.seq < b.seq
+	})
+
+	if n >= 0 && n < len(keys) {
+		keys = keys[:n]
+	}
+
+	result := make([]Entry[K, int], 0, len(keys))
+	for _, key := range keys {
+		result = append(result, Entry[K, int]{Key: key, Value: c.items[key].count})
+	}
+
+	return result
+}
+
+// Merge 将其他计数器的计数累加到当前计数器中。
+func (c *Counter[K]) Merge(others ...*Counter[K]) {
+	for _, other := range others {
+		for _, entry := range other.MostCommon(-1) {
+			c.Add(entry.Key, entry.Value)
+		}
+	}
+}
+
+// Subtract 从当前计数器中减去其他计数器的计数，结果可能为负数。
+func (c *Counter[K]) Subtract(others ...*Counter[K]) {
+	for _, other := range others {
+		for _, entry := range other.MostCommon(-1) {
+			c.Add(entry.Key, -entry.Value)
+		}
+	}
+}
+
+// Clone 返回计数器的拷贝。
+func (c *Counter[K]) Clone() *Counter[K] {
+	res := &Counter[K]{}
+	res.Merge(c)
+	return res
+}
+
+// ToMap 返回元素到计数的映射，与 CountValues 的结果格式相同。
+func (c *Counter[K]) ToMap() map[K]int {
+	result := make(map[K]int, len(c.items))
+	for key, item := range c.items {
+		result[key] = item.count
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package kit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounter(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c := NewCounter(strings.Split("abracadabra", "")...)
+
+	is.Equal(5, c.Get("a"))
+	is.Equal(0, c.Get("z"))
+	is.Equal(5, c.Len())
+	is.Equal(11, c.Total())
+	is.Equal(CountValues(strings.Split("abracadabra", "")), c.ToMap())
+
+	is.Equal(6, c.Inc("a"))
+	is.Equal(1, c.Add("z", 1))
+	is.Equal(0, c.Add("z", -1))
+	is.Equal(5, c.Len())
+
+	is.True(c.Delete("d"))
+	is.False(c.Delete("d"))
+	is.Equal(4, c.Len())
+
+	var zero Counter[int]
+	zero.Inc(1)
+	is.Equal(1, zero.Get(1))
+}
+
+func TestCounterMostCommon(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c := NewCounter(strings.Split("abracadabra", "")...)
+
+	is.Equal([]Entry[string, int]{{"a", 5}, {"b", 2}, {"r", 2}}, c.MostCommon(3))
+	is.Equal([]Entry[string, int]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}, c.MostCommon(-1))
+	is.Equal([]Entry[string, int]{{"a", 5}, {"b", 2}, {"r", 2}, {"c", 1}, {"d", 1}}, c.MostCommon(10))
+	is.Equal([]Entry[string, int]{}, c.MostCommon(0))
+	is.Equal([]Entry[string, int]{}, NewCounter[string]().MostCommon(1))
+
+	entries := NewCounterFromEntries([]Entry[string, int]{{"z", 1}, {"y", 2}, {"x", 1}, {"z", 1}})
+	is.Equal([]Entry[string, int]{{"z", 2}, {"y", 2}, {"x", 1}}, entries.MostCommon(-1))
+	is.Equal(entries.MostCommon(-1), NewCounterFromEntries(entries.MostCommon(-1)).MostCommon(-1))
+}
+
+func TestCounterMergeAndSubtract(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	words := []string{"go", "rust", "go", "zig"}
+
+	c := NewCounterFromMap(CountValues(words))
+	c.Merge(NewCounter("go", "c"), NewCounterFromMap(CountByKey(words, strings.ToUpper)))
+
+	is.Equal(map[string]int{"go": 3, "rust": 1, "zig": 1, "c": 1, "GO": 2, "RUST": 1, "ZIG": 1}, c.ToMap())
+
+	clone := c.Clone()
+	c.Subtract(NewCounter("go", "go", "go", "rust", "rust"))
+
+	is.Equal(map[string]int{"rust": -1, "zig": 1, "c": 1, "GO": 2, "RUST": 1, "ZIG": 1}, c.ToMap())
+	is.Equal(5, c.Total())
+	is.Equal(3, clone.Get("go"))
+}
@@ -0,0 +1,90 @@
+package kit
+
+// DefaultMap 访问不存在的键时使用工厂函数创建默认值的映射。
+type DefaultMap[K comparable, V any] struct {
+	items   map[K]V
+	factory func() V
+}
+
+// NewDefaultMap 创建使用 factory 生成默认值的映射，factory 为 nil 时默认值为零值。
+func NewDefaultMap[K comparable, V any](factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{items: make(map[K]V), factory: factory}
+}
+
+// Get 返回给定键的值，键不存在时使用工厂函数创建默认值并保存。
+func (m *DefaultMap[K, V]) Get(key K) V {
+	if value, ok := m.items[key]; ok {
+		return value
+	}
+
+	value := m.defaultValue()
+	m.items[key] = value
+	return value
+}
+
+// Lookup 返回给定键的值，键不存在时返回零值和 false，不会创建默认值。
+func (m *DefaultMap[K, V]) Lookup(key K) (V, bool) {
+	value, ok := m.items[key]
+	return value, ok
+}
+
+// Has 判断给定的键是否存在。
+func (m *DefaultMap[K, V]) Has(key K) bool {
+	_, ok := m.items[key]
+	return ok
+}
+
+// Set 设置键值对。
+func (m *DefaultMap[K, V]) Set(key K, value V) {
+	m.items[key] = value
+}
+
+// Update 使用 updater 函数更新给定键的值并返回更新后的值，键不存在时以默认值作为参数。
+func (m *DefaultMap[K, V]) Update(key K, updater func(V) V) V {
+	value := updater(m.Get(key))
+	m.items[key] = value
+	return value
+}
+
+// Delete 删除给定的键，返回键是否存在。
+func (m *DefaultMap[K, V]) Delete(key K) bool {
+	_, ok := m.items[key]
+	delete(m.items, key)
+	return ok
+}
+
+// Len 返回映射中键的个数。
+func (m *DefaultMap[K, V]) Len() int {
+	return len(m.items)
+}
+
+// Keys 返回所有的键，顺序是随机的。
+func (m *DefaultMap[K, V]) Keys() []K {
+	return Keys(m.items)
+}
+
+// Values 返回所有的值，顺序是随机的。
+func (m *DefaultMap[K, V]) Values() []V {
+	return Values(m.items)
+}
+
+// Range 遍历映射中的键值对，iteratee 返回 false 时停止遍历。
+func (m *DefaultMap[K, V]) Range(iteratee func(key K, value V) bool) {
+	for key, value := range m.items {
+		if !iteratee(key, value) {
+			return
+		}
+	}
+}
+
+// ToMap 返回映射的浅拷贝。
+func (m *DefaultMap[K, V]) ToMap() map[K]V {
+	return Assign(m.items)
+}
+
+func (m *DefaultMap[K, V]) defaultValue() V {
+	if m.factory == nil {
+		return Empty[V]()
+	}
+	return m.factory()
+}
@@ -0,0 +1,52 @@
+package kit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewDefaultMap[string](func() []int { return []int{} })
+	m.Set("a", append(m.Get("a"), 1))
+	m.Set("a", append(m.Get("a"), 2))
+	m.Update("b", func(v []int) []int { return append(v, 3) })
+
+	is.Equal([]int{1, 2}, m.Get("a"))
+	is.Equal([]int{3}, m.Get("b"))
+	is.Equal([]int{}, m.Get("c"))
+	is.Equal(3, m.Len())
+
+	_, ok := m.Lookup("d")
+	is.False(ok)
+	is.False(m.Has("d"))
+
+	is.True(m.Delete("c"))
+	is.False(m.Delete("c"))
+	is.ElementsMatch([]string{"a", "b"}, m.Keys())
+	is.ElementsMatch([][]int{{1, 2}, {3}}, m.Values())
+	is.Equal(map[string][]int{"a": {1, 2}, "b": {3}}, m.ToMap())
+
+	count := 0
+	m.Range(func(key string, value []int) bool {
+		count++
+		return false
+	})
+	is.Equal(1, count)
+}
+
+func TestDefaultMapZeroValue(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewDefaultMap[string, int](nil)
+	for _, word := range []string{"a", "b", "a"} {
+		m.Update(word, func(v int) int { return v + 1 })
+	}
+
+	is.Equal(map[string]int{"a": 2, "b": 1}, m.ToMap())
+	is.Equal(0, m.Get("c"))
+}
@@ -0,0 +1,166 @@
+package kit
+
+// minDequeCapacity 双端队列的最小容量，必须是 2 的幂。
+const minDequeCapacity = 16
+
+// Deque 基于环形数组的双端队列，两端的插入和删除均摊时间复杂度为 O(1)，支持随机访问。
+// 零值可以直接使用。
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// NewDeque 使用给定的元素创建双端队列。
+func NewDeque[T any](items ...T) *Deque[T] {
+	d := &Deque[T]{}
+
+	for _, item := range items {
+		d.PushBack(item)
+	}
+
+	return d
+}
+
+// Len 返回队列中元素的个数。
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// PushBack 在队尾添加元素。
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+
+	d.buf[d.index(d.count)] = item
+	d.count++
+}
+
+// PushFront 在队首添加元素。
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+
+	d.head = d.prev(d.head)
+	d.buf[d.head] = item
+	d.count++
+}
+
+// PopBack 删除并返回队尾元素，队列为空时返回零值和 false。
+func (d *Deque[T]) PopBack() (T, bool) {
+	if d.count == 0 {
+		return Empty[T](), false
+	}
+
+	i := d.index(d.count - 1)
+	item := d.buf[i]
+	d.buf[i] = Empty[T]()
+	d.count--
+
+	d.shrink()
+	return item, true
+}
+
+// PopFront 删除并返回队首元素，队列为空时返回零值和 false。
+func (d *Deque[T]) PopFront() (T, bool) {
+	if d.count == 0 {
+		return Empty[T](), false
+	}
+
+	item := d.buf[d.head]
+	d.buf[d.head] = Empty[T]()
+	d.head = d.next(d.head)
+	d.count--
+
+	d.shrink()
+	return item, true
+}
+
+// Front 返回队首元素，队列为空时返回零值和 false。
+func (d *Deque[T]) Front() (T, bool) {
+	return d.At(0)
+}
+
+// Back 返回队尾元素，队列为空时返回零值和 false。
+func (d *Deque[T]) Back() (T, bool) {
+	return d.At(d.count - 1)
+}
+
+// At 返回下标 i 处的元素，下标 0 为队首。下标越界时返回零值和 false。
+func (d *Deque[T]) At(i int) (T, bool) {
+	if i < 0 || i >= d.count {
+		return Empty[T](), false
+	}
+	return d.buf[d.index(i)], true
+}
+
+// Set 修改下标 i 处的元素，下标越界时返回 false。
+func (d *Deque[T]) Set(i int, item T) bool {
+	if i < 0 || i >= d.count {
+		return false
+	}
+
+	d.buf[d.index(i)] = item
+	return true
+}
+
+// Clear 清空队列。
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.count = 0
+}
+
+// ToSlice 按从队首到队尾的顺序返回所有元素。
+func (d *Deque[T]) ToSlice() []T {
+	result := make([]T, 0, d.count)
+
+	for i := 0; i < d.count; i++ {
+		result = append(result, d.buf[d.index(i)])
+	}
+
+	return result
+}
+
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) next(i int) int {
+	return (i + 1) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) prev(i int) int {
+	return (i - 1) & (len(d.buf) - 1)
+}
+
+// grow 在缓冲区已满时将容量扩大一倍。
+func (d *Deque[T]) grow() {
+	if d.buf == nil {
+		d.buf = make([]T, minDequeCapacity)
+		return
+	}
+
+	if d.count == len(d.buf) {
+		d.resize(len(d.buf) << 1)
+	}
+}
+
+// shrink 在缓冲区使用率低于四分之一时将容量缩小一半。
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCapacity && d.count<<2 == len(d.buf) {
+		d.resize(len(d.buf) >> 1)
+	}
+}
+
+func (d *Deque[T]) resize(size int) {
+	buf := make([]T, size)
+
+	if d.head+d.count <= len(d.buf) {
+		copy(buf, d.buf[d.head:d.head+d.count])
+	} else {
+		n := copy(buf, d.buf[d.head:])
+		copy(buf[n:], d.buf[:d.index(d.count)])
+	}
+
+	d.buf = buf
+	d.head = 0
+}
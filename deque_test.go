@@ -0,0 +1,109 @@
+package kit
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	d := NewDeque(2, 3)
+	d.PushFront(1)
+	d.PushBack(4)
+
+	is.Equal(4, d.Len())
+	is.Equal([]int{1, 2, 3, 4}, d.ToSlice())
+
+	front, ok := d.Front()
+	is.True(ok)
+	is.Equal(1, front)
+
+	back, ok := d.Back()
+	is.True(ok)
+	is.Equal(4, back)
+
+	item, ok := d.At(2)
+	is.True(ok)
+	is.Equal(3, item)
+
+	_, ok = d.At(4)
+	is.False(ok)
+	_, ok = d.At(-1)
+	is.False(ok)
+
+	is.True(d.Set(1, 20))
+	is.False(d.Set(4, 40))
+
+	item, ok = d.PopFront()
+	is.True(ok)
+	is.Equal(1, item)
+
+	item, ok = d.PopBack()
+	is.True(ok)
+	is.Equal(4, item)
+
+	is.Equal([]int{20, 3}, d.ToSlice())
+
+	d.Clear()
+	is.Equal(0, d.Len())
+
+	_, ok = d.PopFront()
+	is.False(ok)
+	_, ok = d.PopBack()
+	is.False(ok)
+	_, ok = d.Front()
+	is.False(ok)
+	_, ok = d.Back()
+	is.False(ok)
+
+	var zero Deque[string]
+	zero.PushFront("a")
+	is.Equal([]string{"a"}, zero.ToSlice())
+
+	is.Equal([]int{3, 2, 1}, Reverse(NewDeque(1, 2, 3).ToSlice()))
+}
+
+func TestDequeRandom(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	d := NewDeque[int]()
+	expected := []int{}
+
+	for i := 0; i < 5000; i++ {
+		// 前半段以写入为主，后半段以删除为主，覆盖扩容和缩容
+		push := r.Intn(10) < Ternary(i < 2500, 7, 3)
+
+		switch {
+		case push && r.Intn(2) == 0:
+			d.PushFront(i)
+			expected = append([]int{i}, expected...)
+		case push:
+			d.PushBack(i)
+			expected = append(expected, i)
+		case r.Intn(2) == 0:
+			item, ok := d.PopFront()
+			is.Equal(len(expected) > 0, ok)
+			if ok {
+				is.Equal(expected[0], item)
+				expected = expected[1:]
+			}
+		default:
+			item, ok := d.PopBack()
+			is.Equal(len(expected) > 0, ok)
+			if ok {
+				is.Equal(expected[len(expected)-1], item)
+				expected = expected[:len(expected)-1]
+			}
+		}
+
+		is.Equal(len(expected), d.Len())
+	}
+
+	is.Equal(expected, d.ToSlice())
+}
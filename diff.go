@@ -0,0 +1,293 @@
+package kit
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValueChange 记录一个值修改前后的内容。
+type ValueChange[V any] struct {
+	Old V
+	New V
+}
+
+// MapDiff 记录两个 map 之间新增、删除和修改的条目。
+type MapDiff[K comparable, V any] struct {
+	Added   map[K]V
+	Removed map[K]V
+	Changed map[K]ValueChange[V]
+}
+
+// DiffMaps 比较两个 map，返回 b 相对于 a 新增、删除和修改的条目，值使用 reflect.DeepEqual 进行比较。
+func DiffMaps[K comparable, V any](a, b map[K]V) MapDiff[K, V] {
+	diff := MapDiff[K, V]{
+		Added:   map[K]V{},
+		Removed: map[K]V{},
+		Changed: map[K]ValueChange[V]{},
+	}
+
+	for key, old := range a {
+		value, ok := b[key]
+		switch {
+		case !ok:
+			diff.Removed[key] = old
+		case !reflect.DeepEqual(old, value):
+			diff.Changed[key] = ValueChange[V]{Old: old, New: value}
+		}
+	}
+
+	for key, value := range b {
+		if _, ok := a[key]; !ok {
+			diff.Added[key] = value
+		}
+	}
+
+	return diff
+}
+
+// IsEmpty 判断两个 map 是否没有差异。
+func (d MapDiff[K, V]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String 返回可读的差异描述，每行一个条目，按键排序。
+// 新增的条目以 "+" 开头，删除的条目以 "-" 开头，修改的条目以 "~" 开头。
+func (d MapDiff[K, V]) String() string {
+	lines := make([]Entry[string, string], 0, len(d.Added)+len(d.Removed)+len(d.Changed))
+
+	for key, value := range d.Added {
+		lines = append(lines, Entry[string, string]{fmt.Sprint(key), fmt.Sprintf("+ %v: %v", key, value)})
+	}
+	for key, value := range d.Removed {
+		lines = append(lines, Entry[string, string]{fmt.Sprint(key), fmt.Sprintf("- %v: %v", key, value)})
+	}
+	for key, change := range d.Changed {
+		lines = append(lines, Entry[string, string]{fmt.Sprint(key), fmt.Sprintf("~ %v: %v -> %v", key, change.Old, change.New)})
+	}
+
+	sort.Slice(lines, func(i, j int) bool {
+		return lines[i].Key < lines[j].Key
+	})
+
+	return strings.Join(Map(lines, func(_ int, line Entry[string, string]) string { return line.Value }), "\n")
+}
+
+// DiffOp 差异记录的操作类型，取值与 RFC 6902 中的操作名称一致。
+type DiffOp string
+
+const (
+	DiffAdd     DiffOp = "add"
+	DiffRemove  DiffOp = "remove"
+	DiffReplace DiffOp = "replace"
+)
+
+// DiffRecord 一条基于路径的差异记录，Path 的格式与 GetPath 相同，根节点的路径为空字符串，空字符串键写作 [""]。
+type DiffRecord struct {
+	Op   DiffOp
+	Path string
+	Old  any
+	New  any
+}
+
+// Diff DeepDiff 返回的差异记录列表。
+type Diff []DiffRecord
+
+// DeepDiff 递归比较两个嵌套的数据，返回将 a 变为 b 的差异记录。
+//
+// 支持 map、切片、数组、结构体和指针，结构体字段的路径优先使用 json 标签。
+// map 的键按顺序比较；切片按下标比较，多出的元素记录为新增，缺少的元素按下标从大到小记录为删除，
+// 因此记录可以依次应用。类型不同或者无法继续递归的值记录为替换。
+func DeepDiff(a, b any) Diff {
+	diff := Diff{}
+	diff.compare("", reflect.ValueOf(a), reflect.ValueOf(b))
+	return diff
+}
+
+// IsEmpty 判断是否没有差异。
+func (d Diff) IsEmpty() bool {
+	return len(d) == 0
+}
+
+// String 返回可读的差异描述，每行一条记录。
+// 新增的记录以 "+" 开头，删除的记录以 "-" 开头，替换的记录以 "~" 开头。
+func (d Diff) String() string {
+	lines := make([]string, 0, len(d))
+
+	for _, record := range d {
+		switch record.Op {
+		case DiffAdd:
+			lines = append(lines, fmt.Sprintf("+ %s: %v", record.Path, record.New))
+		case DiffRemove:
+			lines = append(lines, fmt.Sprintf("- %s: %v", record.Path, record.Old))
+		default:
+			lines = append(lines, fmt.Sprintf("~ %s: %v -> %v", record.Path, record.Old, record.New))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// JSONPatch 将差异记录渲染为 RFC 6902 格式的 JSON Patch，路径使用 RFC 6901 的 JSON Pointer 表示。
+func (d Diff) JSONPatch() ([]byte, error) {
+	type operation struct {
+		Op    DiffOp `json:"op"`
+		Path  string `json:"path"`
+		Value *any   `json:"value,omitempty"`
+	}
+
+	operations := make([]operation, 0, len(d))
+
+	for _, record := range d {
+		pointer, err := jsonPointer(record.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		op := operation{Op: record.Op, Path: pointer}
+		if record.Op != DiffRemove {
+			value := record.New
+			op.Value = &value
+		}
+
+		operations = append(operations, op)
+	}
+
+	return json.Marshal(operations)
+}
+
+func (d *Diff) compare(path string, a, b reflect.Value) {
+	a, b = indirectValue(a), indirectValue(b)
+
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return
+	case !a.IsValid() || !b.IsValid() || a.Type() != b.Type():
+		d.record(DiffReplace, path, a, b)
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Map:
+		d.compareMap(path, a, b)
+	case reflect.Slice, reflect.Array:
+		d.compareSlice(path, a, b)
+	case reflect.Struct:
+		d.compareStruct(path, a, b)
+	default:
+		if !reflect.DeepEqual(valueInterface(a), valueInterface(b)) {
+			d.record(DiffReplace, path, a, b)
+		}
+	}
+}
+
+func (d *Diff) compareMap(path string, a, b reflect.Value) {
+	keys := a.MapKeys()
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(valueInterface(keys[i])) < fmt.Sprint(valueInterface(keys[j]))
+	})
+
+	for _, key := range keys {
+		child := joinDiffPath(path, fmt.Sprint(valueInterface(key)))
+		oldValue, newValue := a.MapIndex(key), b.MapIndex(key)
+
+		switch {
+		case !newValue.IsValid():
+			d.record(DiffRemove, child, oldValue, reflect.Value{})
+		case !oldValue.IsValid():
+			d.record(DiffAdd, child, reflect.Value{}, newValue)
+		default:
+			d.compare(child, oldValue, newValue)
+		}
+	}
+}
+
+func (d *Diff) compareSlice(path string, a, b reflect.Value) {
+	common := Min(a.Len(), b.Len())
+
+	for i := 0; i < common; i++ {
+		d.compare(path+"["+strconv.Itoa(i)+"]", a.Index(i), b.Index(i))
+	}
+
+	for i := common; i < b.Len(); i++ {
+		d.record(DiffAdd, path+"["+strconv.Itoa(i)+"]", reflect.Value{}, b.Index(i))
+	}
+
+	// 从后往前删除，保证记录依次应用时下标仍然有效
+	for i := a.Len() - 1; i >= common; i-- {
+		d.record(DiffRemove, path+"["+strconv.Itoa(i)+"]", a.Index(i), reflect.Value{})
+	}
+}
+
+func (d *Diff) compareStruct(path string, a, b reflect.Value) {
+	for i := 0; i < a.NumField(); i++ {
+		field := a.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		d.compare(joinDiffPath(path, name), a.Field(i), b.Field(i))
+	}
+}
+
+func (d *Diff) record(op DiffOp, path string, a, b reflect.Value) {
+	*d = append(*d, DiffRecord{Op: op, Path: path, Old: valueInterface(a), New: valueInterface(b)})
+}
+
+// joinDiffPath 将键追加到路径末尾，并对键中的特殊字符进行转义，空字符串键写作 [""]。
+func joinDiffPath(path string, key string) string {
+	if key == "" {
+		return path + `[""]`
+	}
+
+	var builder strings.Builder
+	builder.WriteString(path)
+
+	if path != "" {
+		builder.WriteByte('.')
+	}
+
+	for i := 0; i < len(key); i++ {
+		if c := key[i]; c == '.' || c == '[' || c == '\\' {
+			builder.WriteByte('\\')
+		}
+		builder.WriteByte(key[i])
+	}
+
+	return builder.String()
+}
+
+// jsonPointer 将 GetPath 格式的路径转换为 RFC 6901 的 JSON Pointer。
+func jsonPointer(path string) (string, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	replacer := strings.NewReplacer("~", "~0", "/", "~1")
+
+	for _, segment := range segments {
+		builder.WriteByte('/')
+		builder.WriteString(replacer.Replace(segment.text()))
+	}
+
+	return builder.String(), nil
+}
@@ -0,0 +1,150 @@
+package kit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffMaps(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := DiffMaps(
+		map[string]int{"a": 1, "b": 2, "c": 3},
+		map[string]int{"b": 2, "c": 4, "d": 5},
+	)
+	result2 := DiffMaps(
+		map[string][]int{"a": {1, 2}},
+		map[string][]int{"a": {1, 2}},
+	)
+	result3 := DiffMaps(nil, map[int]string{1: "one"})
+
+	is.Equal(MapDiff[string, int]{
+		Added:   map[string]int{"d": 5},
+		Removed: map[string]int{"a": 1},
+		Changed: map[string]ValueChange[int]{"c": {Old: 3, New: 4}},
+	}, result1)
+	is.False(result1.IsEmpty())
+	is.Equal("- a: 1\n~ c: 3 -> 4\n+ d: 5", result1.String())
+	is.True(result2.IsEmpty())
+	is.Equal("", result2.String())
+	is.Equal(map[int]string{1: "one"}, result3.Added)
+}
+
+func TestDeepDiff(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type server struct {
+		Host  string   `json:"host"`
+		Ports []int    `json:"ports"`
+		Tags  []string `json:"-"`
+		Debug bool
+	}
+
+	a := map[string]any{
+		"name":   "app",
+		"server": &server{Host: "localhost", Ports: []int{80, 443, 8080}, Tags: []string{"a"}},
+		"a.b":    1,
+		"list":   []any{1, "2"},
+		"kind":   1,
+	}
+	b := map[string]any{
+		"server": &server{Host: "example.com", Ports: []int{80}, Tags: []string{"b"}, Debug: true},
+		"a.b":    2,
+		"list":   []any{1, "2", map[string]any{"x": nil}},
+		"kind":   "1",
+		"new":    nil,
+	}
+
+	result1 := DeepDiff(a, b)
+	result2 := DeepDiff(a, a)
+	result3 := DeepDiff(1, 2)
+	result4 := DeepDiff(nil, []int{})
+
+	is.Equal(Diff{
+		{Op: DiffReplace, Path: `a\.b`, Old: 1, New: 2},
+		{Op: DiffReplace, Path: "kind", Old: 1, New: "1"},
+		{Op: DiffAdd, Path: "list[2]", New: map[string]any{"x": nil}},
+		{Op: DiffRemove, Path: "name", Old: "app"},
+		{Op: DiffAdd, Path: "new"},
+		{Op: DiffReplace, Path: "server.host", Old: "localhost", New: "example.com"},
+		{Op: DiffRemove, Path: "server.ports[2]", Old: 8080},
+		{Op: DiffRemove, Path: "server.ports[1]", Old: 443},
+		{Op: DiffReplace, Path: "server.Debug", Old: false, New: true},
+	}, result1)
+	is.True(result2.IsEmpty())
+	is.Equal(Diff{{Op: DiffReplace, Path: "", Old: 1, New: 2}}, result3)
+	is.Equal(Diff{{Op: DiffReplace, Path: "", New: []int{}}}, result4)
+
+	is.Equal(`~ a\.b: 1 -> 2
+~ kind: 1 -> 1
++ list[2]: map[x:<nil>]
+- name: app
++ new: <nil>
+~ server.host: localhost -> example.com
+- server.ports[2]: 8080
+- server.ports[1]: 443
+~ server.Debug: false -> true`, result1.String())
+}
+
+func TestDiffJSONPatch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1, err1 := DeepDiff(
+		map[string]any{"a/b": 1, "c~d": []int{1, 2}, "e": map[string]int{"f": 1}},
+		map[string]any{"a/b": 2, "c~d": []int{1}, "e": map[string]int{"f": 1, "g": 0}},
+	).JSONPatch()
+	result2, err2 := DeepDiff(1, nil).JSONPatch()
+	result3, err3 := Diff{}.JSONPatch()
+
+	is.Nil(err1)
+	is.JSONEq(`[
+		{"op": "replace", "path": "/a~1b", "value": 2},
+		{"op": "remove", "path": "/c~0d/1"},
+		{"op": "add", "path": "/e/g", "value": 0}
+	]`, string(result1))
+	is.Nil(err2)
+	is.JSONEq(`[{"op": "replace", "path": "", "value": null}]`, string(result2))
+	is.Nil(err3)
+	is.Equal("[]", string(result3))
+
+	var patch []map[string]any
+	is.Nil(json.Unmarshal(result1, &patch))
+	is.Len(patch, 3)
+}
+
+func TestDeepDiffEmptyKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := DeepDiff(map[string]any{"": 1}, map[string]any{"": 2})
+	result2 := DeepDiff(
+		map[string]any{"x": map[string]any{"": 1}},
+		map[string]any{"x": map[string]any{"": 1, "y": map[string]int{"": 2}}},
+	)
+
+	is.Equal(Diff{{Op: DiffReplace, Path: `[""]`, Old: 1, New: 2}}, result1)
+	is.Equal(Diff{{Op: DiffAdd, Path: "x.y", New: map[string]int{"": 2}}}, result2)
+
+	patch1, err1 := result1.JSONPatch()
+	is.Nil(err1)
+	is.JSONEq(`[{"op": "replace", "path": "/", "value": 2}]`, string(patch1))
+
+	result3 := DeepDiff(
+		map[string]any{"x": map[string]any{"": []int{1}}},
+		map[string]any{"x": map[string]any{"": []int{2}}},
+	)
+	is.Equal(Diff{{Op: DiffReplace, Path: `x[""][0]`, Old: 1, New: 2}}, result3)
+
+	patch3, err3 := result3.JSONPatch()
+	is.Nil(err3)
+	is.JSONEq(`[{"op": "replace", "path": "/x//0", "value": 2}]`, string(patch3))
+
+	value, ok := GetPath(map[string]any{"x": map[string]any{"": []int{2}}}, result3[0].Path)
+	is.True(ok)
+	is.Equal(2, value)
+}
@@ -0,0 +1,98 @@
+package kit
+
+import "time"
+
+// ExpiringMapConfig 过期映射的配置，所有字段均为可选。
+type ExpiringMapConfig[K comparable, V any] struct {
+	// TTL 键的默认存活时间，小于等于 0 表示永不过期。
+	TTL time.Duration
+	// CleanupInterval 后台清理过期键的间隔，小于等于 0 表示只在访问时惰性删除。
+	CleanupInterval time.Duration
+	// OnEvict 键因过期或被主动删除而移除时的回调，在释放锁之后调用。
+	OnEvict func(key K, value V, reason EvictReason)
+	// Clock 时间来源，默认为 SystemClock。
+	Clock Clock
+}
+
+// ExpiringMap 并发安全的映射，每个键都可以设置独立的存活时间。
+// 过期的键在访问时被惰性删除，也可以通过后台协程或 DeleteExpired 定期清理。
+// ExpiringMap 基于不限制容量的 Cache 实现，只保留与过期相关的操作。
+type ExpiringMap[K comparable, V any] struct {
+	cache *Cache[K, V]
+}
+
+// NewExpiringMap 根据配置创建过期映射。
+// 如果配置了 CleanupInterval，会启动一个后台协程定期清理过期键，不再使用时需要调用 Close。
+func NewExpiringMap[K comparable, V any](config ExpiringMapConfig[K, V]) *ExpiringMap[K, V] {
+	return &ExpiringMap[K, V]{cache: NewCache(CacheConfig[K, V]{
+		TTL:             config.TTL,
+		CleanupInterval: config.CleanupInterval,
+		OnEvict:         config.OnEvict,
+		Clock:           config.Clock,
+	})}
+}
+
+// Get 返回给定键的值，键不存在或已过期时返回零值和 false。
+func (m *ExpiringMap[K, V]) Get(key K) (V, bool) {
+	return m.cache.Get(key)
+}
+
+// Has 判断给定的键是否存在且未过期。
+func (m *ExpiringMap[K, V]) Has(key K) bool {
+	return m.cache.Has(key)
+}
+
+// Set 使用默认的存活时间设置键值对。
+func (m *ExpiringMap[K, V]) Set(key K, value V) {
+	m.cache.Set(key, value)
+}
+
+// SetWithTTL 使用给定的存活时间设置键值对，ttl 小于等于 0 表示永不过期。
+func (m *ExpiringMap[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	m.cache.SetWithTTL(key, value, ttl)
+}
+
+// Touch 将未过期的键的存活时间重置为设置时的存活时间，返回键是否存在且未过期。
+func (m *ExpiringMap[K, V]) Touch(key K) bool {
+	return m.cache.Touch(key)
+}
+
+// Delete 删除给定的键，返回键是否存在且未过期。
+func (m *ExpiringMap[K, V]) Delete(key K) bool {
+	return m.cache.Delete(key)
+}
+
+// DeleteExpired 删除所有过期的键，返回删除的个数。
+func (m *ExpiringMap[K, V]) DeleteExpired() int {
+	return m.cache.DeleteExpired()
+}
+
+// TTL 返回给定键的剩余存活时间。永不过期的键返回 0 和 true，键不存在或已过期时返回 false。
+func (m *ExpiringMap[K, V]) TTL(key K) (time.Duration, bool) {
+	return m.cache.TTL(key)
+}
+
+// Len 返回映射中键的个数，包括已过期但尚未被删除的键。
+func (m *ExpiringMap[K, V]) Len() int {
+	return m.cache.Len()
+}
+
+// Keys 返回所有未过期的键，顺序是随机的。
+func (m *ExpiringMap[K, V]) Keys() []K {
+	return m.cache.Keys()
+}
+
+// Snapshot 返回所有未过期的键值对组成的 map，修改返回值不会影响原映射。
+func (m *ExpiringMap[K, V]) Snapshot() map[K]V {
+	return m.cache.Snapshot()
+}
+
+// Clear 清空映射，不会触发 OnEvict 回调。
+func (m *ExpiringMap[K, V]) Clear() {
+	m.cache.Purge()
+}
+
+// Close 停止后台清理协程，可以重复调用。关闭后映射仍然可以正常读写。
+func (m *ExpiringMap[K, V]) Close() {
+	m.cache.Close()
+}
@@ -0,0 +1,165 @@
+package kit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiringMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Unix(0, 0))
+	m := NewExpiringMap(ExpiringMapConfig[string, int]{TTL: time.Minute, Clock: clock})
+	m.Set("a", 1)
+	m.SetWithTTL("b", 2, time.Second)
+	m.SetWithTTL("c", 3, 0)
+
+	v, ok := m.Get("b")
+	is.True(ok)
+	is.Equal(2, v)
+
+	ttl, ok := m.TTL("a")
+	is.True(ok)
+	is.Equal(time.Minute, ttl)
+
+	ttl, ok = m.TTL("c")
+	is.True(ok)
+	is.Equal(time.Duration(0), ttl)
+
+	clock.Advance(time.Second)
+
+	_, ok = m.Get("b")
+	is.False(ok)
+	is.False(m.Has("b"))
+	is.Equal(2, m.Len())
+	is.Equal(map[string]int{"a": 1, "c": 3}, m.Snapshot())
+	is.ElementsMatch([]string{"a", "c"}, m.Keys())
+
+	_, ok = m.TTL("b")
+	is.False(ok)
+
+	is.True(m.Delete("c"))
+	is.False(m.Delete("c"))
+	is.Equal(map[string]int{"a": 1}, m.Snapshot())
+
+	m.Clear()
+	is.Equal(0, m.Len())
+}
+
+func TestExpiringMapTouch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Unix(0, 0))
+	m := NewExpiringMap(ExpiringMapConfig[string, int]{Clock: clock})
+	m.SetWithTTL("a", 1, 10*time.Second)
+	m.SetWithTTL("b", 2, 10*time.Second)
+
+	clock.Advance(8 * time.Second)
+	is.True(m.Touch("a"))
+
+	ttl, _ := m.TTL("a")
+	is.Equal(10*time.Second, ttl)
+
+	clock.Advance(5 * time.Second)
+	is.True(m.Has("a"))
+	is.False(m.Has("b"))
+	is.False(m.Touch("b"))
+	is.False(m.Touch("c"))
+
+	// 永不过期的键 Touch 后仍然永不过期
+	m.Set("c", 3)
+	is.True(m.Touch("c"))
+	ttl, ok := m.TTL("c")
+	is.True(ok)
+	is.Equal(time.Duration(0), ttl)
+}
+
+func TestExpiringMapOnEvict(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Unix(0, 0))
+	evicted := map[string]EvictReason{}
+
+	m := NewExpiringMap(ExpiringMapConfig[string, int]{
+		TTL:   time.Second,
+		Clock: clock,
+		OnEvict: func(key string, value int, reason EvictReason) {
+			evicted[key] = reason
+		},
+	})
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	m.SetWithTTL("d", 4, time.Hour)
+
+	is.True(m.Delete("a"))
+	clock.Advance(time.Second)
+
+	m.Get("b")
+	is.False(m.Delete("c"))
+	is.Equal(0, m.DeleteExpired())
+	is.Equal(1, m.Len())
+
+	is.Equal(map[string]EvictReason{
+		"a": EvictReasonDeleted,
+		"b": EvictReasonExpired,
+		"c": EvictReasonExpired,
+	}, evicted)
+
+	m.Set("e", 5)
+	clock.Advance(time.Second)
+	is.Equal(1, m.DeleteExpired())
+	is.Equal(EvictReasonExpired, evicted["e"])
+}
+
+func TestExpiringMapBackgroundCleanup(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	clock := NewManualClock(time.Unix(0, 0))
+	m := NewExpiringMap(ExpiringMapConfig[string, int]{
+		TTL:             time.Second,
+		CleanupInterval: time.Millisecond,
+		Clock:           clock,
+	})
+	defer m.Close()
+
+	m.Set("a", 1)
+	clock.Advance(time.Second)
+
+	is.Eventually(func() bool {
+		return m.Len() == 0
+	}, time.Second, time.Millisecond)
+
+	m.Close()
+}
+
+func TestExpiringMapConcurrent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewExpiringMap(ExpiringMapConfig[int, int]{TTL: time.Minute})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				m.Set(i*100+j, j)
+				m.Get(i*100 + j)
+				m.Touch(i*100 + j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	is.Equal(1000, m.Len())
+	is.Len(PickBy(m.Snapshot(), func(k int, v int) bool { return v == 0 }), 10)
+}
@@ -0,0 +1,160 @@
+package kit
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FlattenMap 将嵌套的 map 展开为单层的 map，键为使用 sep 连接的路径，切片元素使用下标作为键。
+// 例如 {"a": {"b": [1, 2]}} 展开为 {"a.b.0": 1, "a.b.1": 2}。空的 map 和切片会作为值保留。
+// 不同路径展开后的键相同时，按键的字典序后出现的值覆盖先出现的值，需要检测冲突时使用 FlattenMapBy。
+func FlattenMap(m map[string]any, sep string) map[string]any {
+	f := flattener{sep: sep, result: map[string]any{}}
+	_ = f.flatten("", reflect.ValueOf(m), true)
+	return f.result
+}
+
+// FlattenMapBy 将嵌套的 map 展开为单层的 map，并使用 transform 转换路径中的每一个键，下标不会被转换。
+// 例如配合 UpperSnakeCase 和 "_" 可以得到环境变量风格的键。transform 为 nil 时不转换。
+// 不同路径展开后的键相同时返回错误。参考 FlattenMap。
+func FlattenMapBy(m map[string]any, sep string, transform func(string) string) (map[string]any, error) {
+	f := flattener{sep: sep, transform: transform, strict: true, result: map[string]any{}}
+	if err := f.flatten("", reflect.ValueOf(m), true); err != nil {
+		return nil, err
+	}
+	return f.result, nil
+}
+
+// UnflattenMap 将使用 sep 连接路径的单层 map 还原为嵌套的 map，FlattenMap 的逆操作。
+// 子键恰好为 0 到 n-1 的节点会被还原为切片。
+// 一个键既是值又是其他键的前缀时返回错误，例如同时存在 "a" 和 "a.b"。
+func UnflattenMap(m map[string]any, sep string) (map[string]any, error) {
+	return UnflattenMapBy(m, sep, nil)
+}
+
+// UnflattenMapBy 将单层的 map 还原为嵌套的 map，并使用 transform 转换路径中的每一个键。
+// transform 为 nil 时不转换，转换后路径相同的键视为冲突。参考 UnflattenMap。
+func UnflattenMapBy(m map[string]any, sep string, transform func(string) string) (map[string]any, error) {
+	root := unflattenNode{}
+
+	keys := Keys(m)
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		parts := []string{key}
+		if sep != "" {
+			parts = strings.Split(key, sep)
+		}
+		if transform != nil {
+			for i, part := range parts {
+				parts[i] = transform(part)
+			}
+		}
+
+		node := root
+		for i, part := range parts[:len(parts)-1] {
+			child, ok := node[part]
+			if !ok {
+				child = unflattenNode{}
+				node[part] = child
+			}
+
+			next, ok := child.(unflattenNode)
+			if !ok {
+				return nil, fmt.Errorf("unflattenMap: key %q conflicts with %q", key, strings.Join(parts[:i+1], sep))
+			}
+			node = next
+		}
+
+		last := parts[len(parts)-1]
+		if _, ok := node[last]; ok {
+			return nil, fmt.Errorf("unflattenMap: key %q conflicts with %q", key, strings.Join(parts, sep))
+		}
+		node[last] = m[key]
+	}
+
+	return root.build(true).(map[string]any), nil
+}
+
+type flattener struct {
+	sep       string
+	transform func(string) string
+	strict    bool
+	result    map[string]any
+}
+
+// flatten 将 v 展开到 result 中，prefix 为 v 对应的键。
+func (f *flattener) flatten(prefix string, v reflect.Value, root bool) error {
+	v = unwrapInterface(v)
+
+	switch {
+	case v.IsValid() && v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String && (root || v.Len() > 0):
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+
+		for _, key := range keys {
+			name := key.String()
+			if f.transform != nil {
+				name = f.transform(name)
+			}
+
+			if err := f.flatten(f.join(prefix, name, root), v.MapIndex(key), false); err != nil {
+				return err
+			}
+		}
+	case v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array) && v.Type().Elem().Kind() != reflect.Uint8 && v.Len() > 0:
+		for i := 0; i < v.Len(); i++ {
+			if err := f.flatten(f.join(prefix, strconv.Itoa(i), root), v.Index(i), false); err != nil {
+				return err
+			}
+		}
+	default:
+		if _, ok := f.result[prefix]; ok && f.strict {
+			return fmt.Errorf("flattenMap: duplicate key %q", prefix)
+		}
+		f.result[prefix] = valueInterface(v)
+	}
+
+	return nil
+}
+
+func (f *flattener) join(prefix string, key string, root bool) string {
+	if root {
+		return key
+	}
+	return prefix + f.sep + key
+}
+
+// unflattenNode 还原过程中创建的中间节点，用于和值本身就是 map 的叶子节点区分。
+type unflattenNode map[string]any
+
+// build 将中间节点转换为 map[string]any，非根节点的子键恰好为 0 到 n-1 时转换为 []any。
+func (n unflattenNode) build(root bool) any {
+	values := make(map[string]any, len(n))
+	for key, value := range n {
+		if node, ok := value.(unflattenNode); ok {
+			value = node.build(false)
+		}
+		values[key] = value
+	}
+
+	if root || len(values) == 0 {
+		return values
+	}
+
+	slice := make([]any, len(values))
+	for key, value := range values {
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= len(slice) || strconv.Itoa(index) != key {
+			return values
+		}
+		slice[index] = value
+	}
+
+	return slice
+}
@@ -0,0 +1,116 @@
+package kit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := FlattenMap(map[string]any{
+		"a": map[string]any{
+			"b": []any{1, map[string]int{"c": 2}},
+			"d": "foo",
+		},
+		"e":     []byte("bar"),
+		"empty": map[string]any{},
+		"list":  []string{},
+		"nil":   nil,
+	}, ".")
+	result2 := FlattenMap(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}}, ".")
+	result3 := FlattenMap(map[string]any{}, "_")
+
+	is.Equal(map[string]any{
+		"a.b.0":   1,
+		"a.b.1.c": 2,
+		"a.d":     "foo",
+		"e":       []byte("bar"),
+		"empty":   map[string]any{},
+		"list":    []string{},
+		"nil":     nil,
+	}, result1)
+	is.Equal(map[string]any{"a.b": 1}, result2)
+	is.Equal(map[string]any{}, result3)
+}
+
+func TestFlattenMapBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1, err1 := FlattenMapBy(map[string]any{
+		"database": map[string]any{
+			"maxConns": 10,
+			"hosts":    []string{"a", "b"},
+		},
+		"debug": true,
+	}, "_", UpperSnakeCase)
+	result2, err2 := FlattenMapBy(map[string]any{"a": map[string]any{"b": 1}}, "/", nil)
+	_, err3 := FlattenMapBy(map[string]any{"fooBar": 1, "foo_bar": 2}, "_", UpperSnakeCase)
+	_, err4 := FlattenMapBy(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}}, ".", nil)
+
+	is.Nil(err1)
+	is.Equal(map[string]any{
+		"DATABASE_MAX_CONNS": 10,
+		"DATABASE_HOSTS_0":   "a",
+		"DATABASE_HOSTS_1":   "b",
+		"DEBUG":              true,
+	}, result1)
+	is.Nil(err2)
+	is.Equal(map[string]any{"a/b": 1}, result2)
+	is.NotNil(err3)
+	is.NotNil(err4)
+}
+
+func TestUnflattenMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	nested := map[string]any{
+		"a": map[string]any{
+			"b": []any{1, map[string]any{"c": 2}},
+			"d": "foo",
+		},
+		"0":     "zero",
+		"empty": map[string]any{},
+		"map":   map[string]any{"0": 1, "2": 2},
+	}
+
+	result1, err1 := UnflattenMap(FlattenMap(nested, "."), ".")
+	result2, err2 := UnflattenMap(map[string]any{"a.b": 1, "a": 2}, ".")
+	result3, err3 := UnflattenMap(map[string]any{"a.b": 1, "a.b.c": 2}, ".")
+	result4, err4 := UnflattenMap(map[string]any{"a.b": 1}, "")
+
+	is.Nil(err1)
+	is.Equal(nested, result1)
+	is.EqualError(err2, `unflattenMap: key "a.b" conflicts with "a"`)
+	is.Nil(result2)
+	is.EqualError(err3, `unflattenMap: key "a.b.c" conflicts with "a.b"`)
+	is.Nil(result3)
+	is.Nil(err4)
+	is.Equal(map[string]any{"a.b": 1}, result4)
+}
+
+func TestUnflattenMapBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1, err1 := UnflattenMapBy(map[string]any{
+		"DATABASE__MAX_CONNS": "10",
+		"DATABASE__HOSTS__0":  "a",
+		"DATABASE__HOSTS__1":  "b",
+	}, "__", strings.ToLower)
+	_, err2 := UnflattenMapBy(map[string]any{"A": 1, "a": 2}, ".", strings.ToLower)
+
+	is.Nil(err1)
+	is.Equal(map[string]any{
+		"database": map[string]any{
+			"max_conns": "10",
+			"hosts":     []any{"a", "b"},
+		},
+	}, result1)
+	is.NotNil(err2)
+}
@@ -0,0 +1,160 @@
+package kit
+
+import (
+	"container/heap"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HeapNode 堆中元素的句柄，可以用于 Fix、Update 和 Remove。
+type HeapNode[T any] struct {
+	Value T
+	index int
+}
+
+// Heap 二叉堆实现的优先队列。
+type Heap[T any] struct {
+	nodes heapNodes[T]
+}
+
+// NewHeap 使用给定的比较函数创建堆。
+//
+// 比较函数，当传入的第一个参数应当先于第二个参数出堆时返回 true。
+func NewHeap[T any](less func(T, T) bool) *Heap[T] {
+	return &Heap[T]{nodes: heapNodes[T]{less: less}}
+}
+
+// NewMinHeap 创建小顶堆，最小的元素先出堆，比较方式与 MinBy 相同。
+func NewMinHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool {
+		return a < b
+	})
+}
+
+// NewMaxHeap 创建大顶堆，最大的元素先出堆，比较方式与 MaxBy 相同。
+func NewMaxHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool {
+		return a > b
+	})
+}
+
+// HeapFromSlice 使用集合中的元素以 O(n) 的时间复杂度建堆，不会修改原集合。
+// 返回的句柄与集合中的元素一一对应，可以用于 Fix、Update 和 Remove。
+func HeapFromSlice[T any](slice []T, less func(T, T) bool) (*Heap[T], []*HeapNode[T]) {
+	h := NewHeap(less)
+	nodes := make([]*HeapNode[T], 0, len(slice))
+
+	for i, item := range slice {
+		nodes = append(nodes, &HeapNode[T]{Value: item, index: i})
+	}
+
+	h.nodes.items = append(make([]*HeapNode[T], 0, len(nodes)), nodes...)
+	heap.Init(&h.nodes)
+
+	return h, nodes
+}
+
+// Len 返回堆中元素的个数。
+func (h *Heap[T]) Len() int {
+	return h.nodes.Len()
+}
+
+// Push 将元素加入堆中，返回元素的句柄。
+func (h *Heap[T]) Push(value T) *HeapNode[T] {
+	node := &HeapNode[T]{Value: value}
+	heap.Push(&h.nodes, node)
+	return node
+}
+
+// Pop 弹出堆顶元素，堆为空时返回零值和 false。
+func (h *Heap[T]) Pop() (T, bool) {
+	if h.Len() == 0 {
+		return Empty[T](), false
+	}
+	return heap.Pop(&h.nodes).(*HeapNode[T]).Value, true
+}
+
+// Peek 返回堆顶元素但不弹出，堆为空时返回零值和 false。
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.Len() == 0 {
+		return Empty[T](), false
+	}
+	return h.nodes.items[0].Value, true
+}
+
+// Fix 在句柄的值被修改后重新调整其在堆中的位置。
+// 如果句柄已经不在堆中，则不做任何操作。
+func (h *Heap[T]) Fix(node *HeapNode[T]) {
+	if h.contains(node) {
+		heap.Fix(&h.nodes, node.index)
+	}
+}
+
+// Update 修改句柄的值并重新调整其在堆中的位置，可用于实现 decrease-key。
+// 如果句柄已经不在堆中，则返回 false。
+func (h *Heap[T]) Update(node *HeapNode[T], value T) bool {
+	if !h.contains(node) {
+		return false
+	}
+
+	node.Value = value
+	heap.Fix(&h.nodes, node.index)
+
+	return true
+}
+
+// Remove 从堆中删除句柄对应的元素。如果句柄已经不在堆中，则返回 false。
+func (h *Heap[T]) Remove(node *HeapNode[T]) bool {
+	if !h.contains(node) {
+		return false
+	}
+
+	heap.Remove(&h.nodes, node.index)
+	return true
+}
+
+// Values 按堆的内部顺序返回所有元素，除第一个元素外不保证有序。
+func (h *Heap[T]) Values() []T {
+	return Map(h.nodes.items, func(_ int, node *HeapNode[T]) T {
+		return node.Value
+	})
+}
+
+func (h *Heap[T]) contains(node *HeapNode[T]) bool {
+	return node != nil && node.index >= 0 && node.index < h.Len() && h.nodes.items[node.index] == node
+}
+
+// heapNodes 实现 heap.Interface
+type heapNodes[T any] struct {
+	items []*HeapNode[T]
+	less  func(T, T) bool
+}
+
+func (h *heapNodes[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *heapNodes[T]) Less(i, j int) bool {
+	return h.less(h.items[i].Value, h.items[j].Value)
+}
+
+func (h *heapNodes[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *heapNodes[T]) Push(x any) {
+	node := x.(*HeapNode[T])
+	node.index = len(h.items)
+	h.items = append(h.items, node)
+}
+
+func (h *heapNodes[T]) Pop() any {
+	n := len(h.items)
+	node := h.items[n-1]
+	h.items[n-1] = nil
+	h.items = h.items[:n-1]
+	node.index = -1
+	return node
+}
@@ -0,0 +1,165 @@
+package kit
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	h := NewMinHeap[int]()
+	for _, item := range []int{5, 3, 8, 1, 9, 2} {
+		h.Push(item)
+	}
+
+	is.Equal(6, h.Len())
+
+	top, ok := h.Peek()
+	is.True(ok)
+	is.Equal(1, top)
+
+	result := []int{}
+	for h.Len() > 0 {
+		item, _ := h.Pop()
+		result = append(result, item)
+	}
+	is.Equal([]int{1, 2, 3, 5, 8, 9}, result)
+
+	_, ok = h.Pop()
+	is.False(ok)
+	_, ok = h.Peek()
+	is.False(ok)
+}
+
+func TestMaxHeap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	h := NewMaxHeap[string]()
+	h.Push("b")
+	h.Push("c")
+	h.Push("a")
+
+	r1, _ := h.Pop()
+	r2, _ := h.Pop()
+	r3, _ := h.Pop()
+
+	is.Equal([]string{"c", "b", "a"}, []string{r1, r2, r3})
+}
+
+func TestHeapFromSlice(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	slice := rand.New(rand.NewSource(1)).Perm(100)
+	origin := append([]int{}, slice...)
+
+	type task struct {
+		name     string
+		priority int
+	}
+
+	h, nodes := HeapFromSlice(Map(slice, func(_ int, p int) task {
+		return task{name: ToString(p), priority: p}
+	}), func(a, b task) bool {
+		return a.priority > b.priority
+	})
+
+	is.Equal(origin, slice)
+	is.Equal(100, h.Len())
+	is.Len(h.Values(), 100)
+	is.Len(nodes, 100)
+
+	// 句柄与原集合中的元素一一对应，可以直接调整优先级
+	for i, node := range nodes {
+		is.Equal(slice[i], node.Value.priority)
+	}
+
+	lowest := nodes[IndexOf(slice, 0)]
+	is.True(h.Update(lowest, task{name: lowest.Value.name, priority: 100}))
+	top, _ := h.Pop()
+	is.Equal(task{name: "0", priority: 100}, top)
+	is.True(h.Remove(nodes[IndexOf(slice, 99)]))
+	is.Equal(98, h.Len())
+
+	for i := 98; i >= 1; i-- {
+		item, ok := h.Pop()
+		is.True(ok)
+		is.Equal(i, item.priority)
+	}
+}
+
+func TestHeapHandle(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	h := NewMinHeap[int]()
+	nodes := Map([]int{10, 20, 30, 40}, func(_ int, v int) *HeapNode[int] {
+		return h.Push(v)
+	})
+
+	// decrease-key
+	is.True(h.Update(nodes[3], 5))
+	top, _ := h.Peek()
+	is.Equal(5, top)
+
+	nodes[0].Value = 50
+	h.Fix(nodes[0])
+
+	is.True(h.Remove(nodes[1]))
+	is.False(h.Remove(nodes[1]))
+	is.False(h.Update(nodes[1], 1))
+
+	result := []int{}
+	for h.Len() > 0 {
+		item, _ := h.Pop()
+		result = append(result, item)
+	}
+	is.Equal([]int{5, 30, 50}, result)
+
+	// 已出堆的句柄不再生效
+	is.False(h.Remove(nodes[2]))
+	h.Fix(nodes[2])
+	is.Equal(0, h.Len())
+
+	other := NewMinHeap[int]()
+	other.Push(1)
+	is.False(other.Remove(h.Push(1)))
+}
+
+func TestHeapRandom(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	h := NewMinHeap[int]()
+	nodes := []*HeapNode[int]{}
+
+	for i := 0; i < 500; i++ {
+		nodes = append(nodes, h.Push(r.Intn(1000)))
+	}
+
+	for i := 0; i < 200; i++ {
+		node := nodes[r.Intn(len(nodes))]
+		if r.Intn(2) == 0 {
+			h.Update(node, r.Intn(1000))
+		} else {
+			h.Remove(node)
+		}
+	}
+
+	expected := h.Values()
+	sort.Ints(expected)
+
+	result := []int{}
+	for h.Len() > 0 {
+		item, _ := h.Pop()
+		result = append(result, item)
+	}
+	is.Equal(expected, result)
+}
@@ -0,0 +1,129 @@
+package kit
+
+// listNode 双向链表节点
+type listNode[T any] struct {
+	value      T
+	prev, next *listNode[T]
+	list       *linkedList[T]
+}
+
+// linkedList 带哨兵节点的双向链表，零值可以直接使用。
+type linkedList[T any] struct {
+	root listNode[T]
+	size int
+}
+
+func (l *linkedList[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+func (l *linkedList[T]) len() int {
+	return l.size
+}
+
+// front 返回链表的第一个节点，链表为空时返回 nil。
+func (l *linkedList[T]) front() *listNode[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// back 返回链表的最后一个节点，链表为空时返回 nil。
+func (l *linkedList[T]) back() *listNode[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// nextOf 返回节点的下一个节点，已经是最后一个节点时返回 nil。
+func (l *linkedList[T]) nextOf(n *listNode[T]) *listNode[T] {
+	if n.next == &l.root {
+		return nil
+	}
+	return n.next
+}
+
+// prevOf 返回节点的上一个节点，已经是第一个节点时返回 nil。
+func (l *linkedList[T]) prevOf(n *listNode[T]) *listNode[T] {
+	if n.prev == &l.root {
+		return nil
+	}
+	return n.prev
+}
+
+func (l *linkedList[T]) pushFront(value T) *listNode[T] {
+	l.lazyInit()
+	return l.insertAfter(&listNode[T]{value: value}, &l.root)
+}
+
+func (l *linkedList[T]) pushBack(value T) *listNode[T] {
+	l.lazyInit()
+	return l.insertAfter(&listNode[T]{value: value}, l.root.prev)
+}
+
+// remove 从链表中删除节点，节点不属于该链表时不做任何操作。
+func (l *linkedList[T]) remove(n *listNode[T]) {
+	if n.list != l {
+		return
+	}
+
+	n.prev.next = n.next
+	n.next.prev = n.prev
+	n.prev, n.next, n.list = nil, nil, nil
+	l.size--
+}
+
+func (l *linkedList[T]) moveToFront(n *listNode[T]) {
+	if n.list != l || l.root.next == n {
+		return
+	}
+	l.move(n, &l.root)
+}
+
+func (l *linkedList[T]) moveToBack(n *listNode[T]) {
+	if n.list != l || l.root.prev == n {
+		return
+	}
+	l.move(n, l.root.prev)
+}
+
+// values 按顺序返回链表中所有节点的值。
+func (l *linkedList[T]) values() []T {
+	result := make([]T, 0, l.size)
+
+	for n := l.front(); n != nil; n = l.nextOf(n) {
+		result = append(result, n.value)
+	}
+
+	return result
+}
+
+func (l *linkedList[T]) insertAfter(n, at *listNode[T]) *listNode[T] {
+	n.prev = at
+	n.next = at.next
+	n.prev.next = n
+	n.next.prev = n
+	n.list = l
+	l.size++
+	return n
+}
+
+// move 将节点 n 移动到节点 at 之后。
+func (l *linkedList[T]) move(n, at *listNode[T]) {
+	if n == at {
+		return
+	}
+
+	n.prev.next = n.next
+	n.next.prev = n.prev
+
+	n.prev = at
+	n.next = at.next
+	n.prev.next = n
+	n.next.prev = n
+}
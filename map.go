@@ -1,5 +1,11 @@
 package kit
 
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
 // Assign 从左到右合并多个映射关系。
 func Assign[K comparable, V any](maps ...map[K]V) map[K]V {
 	res := make(map[K]V)
@@ -13,6 +19,24 @@ func Assign[K comparable, V any](maps ...map[K]V) map[K]V {
 	return res
 }
 
+// DifferenceKeys 返回 in 中存在、但 others 中都不存在的键。
+func DifferenceKeys[K comparable, V any](in map[K]V, others ...map[K]V) []K {
+	return Keys(DifferenceMaps(in, others...))
+}
+
+// DifferenceMaps 返回 in 中键在 others 中都不存在的键值对。
+func DifferenceMaps[K comparable, V any](in map[K]V, others ...map[K]V) map[K]V {
+	res := make(map[K]V)
+
+	for k, v := range in {
+		if !hasKeyInAny(k, others) {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
 // Entries 将 map 转换为包含键值对的数组。
 func Entries[K comparable, V any](in map[K]V) []Entry[K, V] {
 	entries := make([]Entry[K, V], 0, len(in))
@@ -27,6 +51,19 @@ func Entries[K comparable, V any](in map[K]V) []Entry[K, V] {
 	return entries
 }
 
+// FilterMapValues 同时对映射的值进行过滤和转换，iteratee 返回 false 时删除该键值对。
+func FilterMapValues[K comparable, V any, R any](in map[K]V, iteratee func(K, V) (R, bool)) map[K]R {
+	res := make(map[K]R)
+
+	for k, v := range in {
+		if r, ok := iteratee(k, v); ok {
+			res[k] = r
+		}
+	}
+
+	return res
+}
+
 // FindKey 返回第一个值匹配的键。
 func FindKey[K comparable, V comparable](object map[K]V, value V) (K, bool) {
 	for k, v := range object {
@@ -49,6 +86,33 @@ func FindKeyBy[K comparable, V any](object map[K]V, predicate func(key K, value
 	return Empty[K](), false
 }
 
+// FindKeyByOrdered 返回与 predicate 返回值匹配的最小的键，结果与遍历顺序无关。
+func FindKeyByOrdered[K constraints.Ordered, V any](object map[K]V, predicate func(key K, value V) bool) (K, bool) {
+	result, found := Empty[K](), false
+
+	for k, v := range object {
+		if (!found || k < result) && predicate(k, v) {
+			result, found = k, true
+		}
+	}
+
+	return result, found
+}
+
+// FindKeyOrdered 返回值匹配的最小的键，结果与遍历顺序无关。
+func FindKeyOrdered[K constraints.Ordered, V comparable](object map[K]V, value V) (K, bool) {
+	return FindKeyByOrdered(object, func(_ K, v V) bool {
+		return v == value
+	})
+}
+
+// ForEachSorted 按键从小到大的顺序遍历映射。
+func ForEachSorted[K constraints.Ordered, V any](in map[K]V, iteratee func(K, V)) {
+	for _, k := range SortedKeys(in) {
+		iteratee(k, in[k])
+	}
+}
+
 // FromEntries 将包含键值对的数组转化为 map。
 func FromEntries[K comparable, V any](entries []Entry[K, V]) map[K]V {
 	res := make(map[K]V, len(entries))
@@ -65,6 +129,27 @@ func FromPairs[K comparable, V any](entries []Entry[K, V]) map[K]V {
 	return FromEntries(entries)
 }
 
+// IntersectKeys 返回所有映射中都存在的键。
+func IntersectKeys[K comparable, V any](maps ...map[K]V) []K {
+	return Keys(IntersectMaps(maps...))
+}
+
+// IntersectMaps 返回键在所有映射中都存在的键值对，值取自第一个映射。
+func IntersectMaps[K comparable, V any](maps ...map[K]V) map[K]V {
+	res := make(map[K]V)
+	if len(maps) == 0 {
+		return res
+	}
+
+	for k, v := range maps[0] {
+		if hasKeyInAll(k, maps[1:]) {
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
 // Invert 反转映射中键值关系。如果映射中出现重复的值，后遍历的值则会覆盖先前的值。
 func Invert[K comparable, V comparable](in map[K]V) map[V]K {
 	res := make(map[V]K, len(in))
@@ -87,6 +172,60 @@ func Keys[K comparable, V any](in map[K]V) []K {
 	return keys
 }
 
+// MapEntries 根据 iteratee 函数同时转换映射的键和值。如果转换后出现重复的键，后遍历的键值对则会覆盖先前的键值对。
+func MapEntries[K1 comparable, V1 any, K2 comparable, V2 any](in map[K1]V1, iteratee func(K1, V1) (K2, V2)) map[K2]V2 {
+	res := make(map[K2]V2, len(in))
+
+	for k, v := range in {
+		k2, v2 := iteratee(k, v)
+		res[k2] = v2
+	}
+
+	return res
+}
+
+// MapKeys 根据 iteratee 函数转换映射的键。如果转换后出现重复的键，后遍历的值则会覆盖先前的值，
+// 需要处理冲突时使用 MapKeysWith 或 MapKeysStrict。
+func MapKeys[K comparable, V any, R comparable](in map[K]V, iteratee func(K, V) R) map[R]V {
+	res := make(map[R]V, len(in))
+
+	for k, v := range in {
+		res[iteratee(k, v)] = v
+	}
+
+	return res
+}
+
+// MapKeysStrict 根据 iteratee 函数转换映射的键，转换后出现重复的键时返回错误。
+func MapKeysStrict[K comparable, V any, R comparable](in map[K]V, iteratee func(K, V) R) (map[R]V, error) {
+	res := make(map[R]V, len(in))
+
+	for k, v := range in {
+		r := iteratee(k, v)
+		if _, ok := res[r]; ok {
+			return nil, fmt.Errorf("mapKeys: duplicate key %v", r)
+		}
+		res[r] = v
+	}
+
+	return res, nil
+}
+
+// MapKeysWith 根据 iteratee 函数转换映射的键，转换后出现重复的键时使用 resolve 函数合并已有的值和新的值。
+func MapKeysWith[K comparable, V any, R comparable](in map[K]V, iteratee func(K, V) R, resolve func(key R, existing V, value V) V) map[R]V {
+	res := make(map[R]V, len(in))
+
+	for k, v := range in {
+		r := iteratee(k, v)
+		if existing, ok := res[r]; ok {
+			v = resolve(r, existing, v)
+		}
+		res[r] = v
+	}
+
+	return res
+}
+
 // MapToSlice 根据提供的 iteratee 函数将 map 转换为数组。
 func MapToSlice[K comparable, V any, R any](in map[K]V, iteratee func(K, V) R) []R {
 	res := make([]R, 0, len(in))
@@ -98,6 +237,17 @@ func MapToSlice[K comparable, V any, R any](in map[K]V, iteratee func(K, V) R) [
 	return res
 }
 
+// MapValues 根据 iteratee 函数转换映射的值，键保持不变。
+func MapValues[K comparable, V any, R any](in map[K]V, iteratee func(K, V) R) map[K]R {
+	res := make(map[K]R, len(in))
+
+	for k, v := range in {
+		res[k] = iteratee(k, v)
+	}
+
+	return res
+}
+
 // OmitBy 使用 predicate 函数对映射进行过滤，删除返回值为 true 的键值对。
 func OmitBy[K comparable, V any](in map[K]V, predicate func(K, V) bool) map[K]V {
 	res := make(map[K]V)
@@ -149,6 +299,22 @@ func OmitByValues[K comparable, V comparable](in map[K]V, values []V) map[K]V {
 	return res
 }
 
+// PartitionMap 使用 predicate 函数将映射拆分为两个映射，
+// 第一个映射包含返回值为 true 的键值对，第二个映射包含返回值为 false 的键值对。
+func PartitionMap[K comparable, V any](in map[K]V, predicate func(K, V) bool) (map[K]V, map[K]V) {
+	yes, no := make(map[K]V), make(map[K]V)
+
+	for k, v := range in {
+		if predicate(k, v) {
+			yes[k] = v
+		} else {
+			no[k] = v
+		}
+	}
+
+	return yes, no
+}
+
 // PickBy 使用 predicate 函数对映射进行过滤，保留返回值为 true 的键值对。
 func PickBy[K comparable, V any](in map[K]V, predicate func(K, V) bool) map[K]V {
 	res := make(map[K]V)
@@ -194,11 +360,88 @@ func PickByValues[K comparable, V comparable](in map[K]V, values []V) map[K]V {
 	return res
 }
 
+// ReduceMap 将映射的键值对依次累加到 initial 上，遍历顺序不固定，需要固定顺序时配合 SortedEntries 使用 Reduce。
+func ReduceMap[K comparable, V any, R any](in map[K]V, accumulator func(acc R, key K, value V) R, initial R) R {
+	for k, v := range in {
+		initial = accumulator(initial, k, v)
+	}
+
+	return initial
+}
+
+// SortedEntries 返回按键从小到大排列的键值对数组。
+func SortedEntries[K constraints.Ordered, V any](in map[K]V) []Entry[K, V] {
+	return SortedEntriesFunc(in, func(a, b Entry[K, V]) int {
+		return Asc(a.Key, b.Key)
+	})
+}
+
+// SortedEntriesFunc 返回使用比较函数排列的键值对数组，可以同时根据键和值进行排序。
+func SortedEntriesFunc[K comparable, V any](in map[K]V, comparator Comparator[Entry[K, V]]) []Entry[K, V] {
+	return SortStableFunc(Entries(in), comparator)
+}
+
+// SortedKeys 返回从小到大排列的所有键。
+func SortedKeys[K constraints.Ordered, V any](in map[K]V) []K {
+	return Sort(Keys(in))
+}
+
+// SortedKeysFunc 返回使用比较函数排列的所有键。
+func SortedKeysFunc[K comparable, V any](in map[K]V, comparator Comparator[K]) []K {
+	return SortStableFunc(Keys(in), comparator)
+}
+
+// SortedValues 返回按键从小到大排列的所有值，与 SortedKeys 的结果一一对应。
+func SortedValues[K constraints.Ordered, V any](in map[K]V) []V {
+	return valuesOf(in, SortedKeys(in))
+}
+
+// SortedValuesFunc 返回按键使用比较函数排列的所有值，与 SortedKeysFunc 的结果一一对应。
+func SortedValuesFunc[K comparable, V any](in map[K]V, comparator Comparator[K]) []V {
+	return valuesOf(in, SortedKeysFunc(in, comparator))
+}
+
+// SymmetricDifferenceKeys 返回只在其中一个映射中存在的键。
+func SymmetricDifferenceKeys[K comparable, V1 any, V2 any](a map[K]V1, b map[K]V2) []K {
+	keys := []K{}
+
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	return keys
+}
+
 // ToPairs 将 map 转换为包含键值对的数组，等价于 Entries。
 func ToPairs[K comparable, V any](in map[K]V) []Entry[K, V] {
 	return Entries(in)
 }
 
+// UnionKeys 返回在任意一个映射中存在的键，结果不包含重复的键。
+func UnionKeys[K comparable, V any](maps ...map[K]V) []K {
+	seen := make(map[K]struct{})
+	keys := []K{}
+
+	for _, m := range maps {
+		for k := range m {
+			if _, ok := seen[k]; !ok {
+				seen[k] = struct{}{}
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	return keys
+}
+
 // ValueOr 返回给定键的值，如果键不存在则返回回退值。
 func ValueOr[K comparable, V any](in map[K]V, key K, fallback V) V {
 	if v, ok := in[key]; ok {
@@ -218,3 +461,33 @@ func Values[K comparable, V any](in map[K]V) []V {
 
 	return vals
 }
+
+func valuesOf[K comparable, V any](in map[K]V, keys []K) []V {
+	vals := make([]V, 0, len(keys))
+
+	for _, k := range keys {
+		vals = append(vals, in[k])
+	}
+
+	return vals
+}
+
+func hasKeyInAll[K comparable, V any](key K, maps []map[K]V) bool {
+	for _, m := range maps {
+		if _, ok := m[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasKeyInAny[K comparable, V any](key K, maps []map[K]V) bool {
+	for _, m := range maps {
+		if _, ok := m[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -156,3 +156,10 @@ func ExampleMapToSlice() {
 	fmt.Printf("%v", result)
 	// Output: [1_1 2_2 3_3 4_4]
 }
+
+func ExampleSortedKeys() {
+	kv := map[string]int{"foo": 1, "bar": 2, "baz": 3}
+
+	fmt.Printf("%v %v", SortedKeys(kv), SortedValues(kv))
+	// Output: [bar baz foo] [2 3 1]
+}
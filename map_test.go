@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -260,3 +261,259 @@ func TestFindKeyBy(t *testing.T) {
 	is.Equal("", result2)
 	is.False(ok2)
 }
+
+func TestFindKeyOrdered(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1, ok1 := FindKeyOrdered(map[string]int{"foo": 1, "bar": 2, "baz": 2, "qux": 2}, 2)
+	result2, ok2 := FindKeyOrdered(map[int]string{3: "a", 1: "b", 2: "a"}, "a")
+	result3, ok3 := FindKeyOrdered(map[string]int{"foo": 1}, 42)
+
+	is.Equal("bar", result1)
+	is.True(ok1)
+	is.Equal(2, result2)
+	is.True(ok2)
+	is.Equal("", result3)
+	is.False(ok3)
+}
+
+func TestFindKeyByOrdered(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for i := 0; i < 10; i++ {
+		result1, ok1 := FindKeyByOrdered(map[string]int{"foo": 1, "bar": 2, "baz": 3, "qux": 4}, func(k string, v int) bool {
+			return v > 1
+		})
+		is.Equal("bar", result1)
+		is.True(ok1)
+	}
+
+	result2, ok2 := FindKeyByOrdered(map[int]int{1: 1}, func(k int, v int) bool {
+		return false
+	})
+	is.Equal(0, result2)
+	is.False(ok2)
+}
+
+func TestSortedKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]string{"bar", "baz", "foo"}, SortedKeys(map[string]int{"foo": 1, "bar": 2, "baz": 3}))
+	is.Equal([]int{}, SortedKeys(map[int]int{}))
+	is.Equal([]int{3, 2, 1}, SortedKeysFunc(map[int]bool{1: true, 2: true, 3: false}, Desc[int]))
+}
+
+func TestSortedValues(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]int{2, 3, 1}, SortedValues(map[string]int{"foo": 1, "bar": 2, "baz": 3}))
+	is.Equal([]string{"c", "b", "a"}, SortedValuesFunc(map[int]string{1: "a", 2: "b", 3: "c"}, Desc[int]))
+}
+
+func TestSortedEntries(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := SortedEntries(map[string]int{"foo": 1, "bar": 2, "baz": 3})
+	result2 := SortedEntriesFunc(map[string]int{"foo": 2, "bar": 1, "baz": 2}, Compare(
+		By(func(e Entry[string, int]) int { return e.Value }).Desc(),
+		By(func(e Entry[string, int]) string { return e.Key }).Asc(),
+	))
+
+	is.Equal([]Entry[string, int]{{"bar", 2}, {"baz", 3}, {"foo", 1}}, result1)
+	is.Equal([]Entry[string, int]{{"baz", 2}, {"foo", 2}, {"bar", 1}}, result2)
+}
+
+func TestMapKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := MapKeys(map[int]int{1: 1, 2: 2}, func(k int, v int) string {
+		return strconv.Itoa(k * 10)
+	})
+	result2 := MapKeys(map[string]int{"a": 1, "A": 1}, func(k string, v int) string {
+		return strings.ToUpper(k)
+	})
+
+	is.Equal(map[string]int{"10": 1, "20": 2}, result1)
+	is.Equal(map[string]int{"A": 1}, result2)
+}
+
+func TestMapKeysWith(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := MapKeysWith(map[string]int{"a": 1, "A": 2, "b": 3}, func(k string, v int) string {
+		return strings.ToUpper(k)
+	}, func(key string, existing int, value int) int {
+		return existing + value
+	})
+
+	is.Equal(map[string]int{"A": 3, "B": 3}, result1)
+}
+
+func TestMapKeysStrict(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	toUpper := func(k string, v int) string {
+		return strings.ToUpper(k)
+	}
+
+	result1, err1 := MapKeysStrict(map[string]int{"a": 1, "b": 2}, toUpper)
+	result2, err2 := MapKeysStrict(map[string]int{"a": 1, "A": 2}, toUpper)
+
+	is.Nil(err1)
+	is.Equal(map[string]int{"A": 1, "B": 2}, result1)
+	is.EqualError(err2, "mapKeys: duplicate key A")
+	is.Nil(result2)
+}
+
+func TestMapValues(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := MapValues(map[string]int{"a": 1, "b": 2}, func(k string, v int) string {
+		return k + strconv.Itoa(v)
+	})
+
+	is.Equal(map[string]string{"a": "a1", "b": "b2"}, result1)
+}
+
+func TestMapEntries(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := MapEntries(map[string]int{"a": 1, "b": 2}, func(k string, v int) (int, string) {
+		return v, k
+	})
+
+	is.Equal(map[int]string{1: "a", 2: "b"}, result1)
+}
+
+func TestFilterMapValues(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := FilterMapValues(map[string]string{"a": "1", "b": "x", "c": "3"}, func(k string, v string) (int, bool) {
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	})
+
+	is.Equal(map[string]int{"a": 1, "c": 3}, result1)
+}
+
+func TestReduceMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := ReduceMap(map[string]int{"a": 1, "b": 2, "c": 3}, func(acc int, k string, v int) int {
+		return acc + v
+	}, 10)
+	result2 := ReduceMap(map[string]int{}, func(acc int, k string, v int) int {
+		return acc + v
+	}, 10)
+
+	is.Equal(16, result1)
+	is.Equal(10, result2)
+}
+
+func TestForEachSorted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := []string{}
+	ForEachSorted(map[string]int{"foo": 1, "bar": 2, "baz": 3}, func(k string, v int) {
+		result1 = append(result1, fmt.Sprintf("%s=%d", k, v))
+	})
+
+	is.Equal([]string{"bar=2", "baz=3", "foo=1"}, result1)
+}
+
+func TestIntersectMaps(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := IntersectMaps(
+		map[string]int{"a": 1, "b": 2, "c": 3},
+		map[string]int{"b": 20, "c": 30, "d": 40},
+		map[string]int{"c": 300, "b": 200},
+	)
+	result2 := IntersectMaps(map[string]int{"a": 1})
+	result3 := IntersectMaps[string, int]()
+
+	is.Equal(map[string]int{"b": 2, "c": 3}, result1)
+	is.Equal(map[string]int{"a": 1}, result2)
+	is.Equal(map[string]int{}, result3)
+}
+
+func TestIntersectKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := IntersectKeys(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4})
+
+	is.Equal([]string{"b"}, result1)
+}
+
+func TestDifferenceMaps(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := DifferenceMaps(
+		map[string]int{"a": 1, "b": 2, "c": 3, "d": 4},
+		map[string]int{"b": 20},
+		map[string]int{"d": 40, "e": 50},
+	)
+	result2 := DifferenceMaps(map[string]int{"a": 1})
+
+	is.Equal(map[string]int{"a": 1, "c": 3}, result1)
+	is.Equal(map[string]int{"a": 1}, result2)
+}
+
+func TestDifferenceKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := DifferenceKeys(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4})
+
+	is.Equal([]string{"a"}, result1)
+}
+
+func TestSymmetricDifferenceKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := SymmetricDifferenceKeys(map[string]int{"a": 1, "b": 2}, map[string]bool{"b": true, "c": false})
+	result2 := SymmetricDifferenceKeys(map[string]int{"a": 1}, map[string]int{"a": 2})
+
+	is.ElementsMatch([]string{"a", "c"}, result1)
+	is.Equal([]string{}, result2)
+}
+
+func TestUnionKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := UnionKeys(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4})
+	result2 := UnionKeys[string, int]()
+
+	is.ElementsMatch([]string{"a", "b", "c"}, result1)
+	is.Equal([]string{}, result2)
+}
+
+func TestPartitionMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	yes, no := PartitionMap(map[string]int{"a": 1, "b": 2, "c": 3}, func(k string, v int) bool {
+		return v%2 == 1
+	})
+
+	is.Equal(map[string]int{"a": 1, "c": 3}, yes)
+	is.Equal(map[string]int{"b": 2}, no)
+}
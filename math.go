@@ -1,7 +1,10 @@
 package kit
 
 import (
+	"fmt"
 	"math"
+	"math/rand"
+	"sort"
 
 	"golang.org/x/exp/constraints"
 )
@@ -36,6 +39,13 @@ func AverageBy[T any, U constraints.Float | constraints.Integer](slice []T, iter
 	return sum / U(len(slice))
 }
 
+// BottomK 返回集合中最小的 k 个元素，结果从小到大排列。
+func BottomK[T constraints.Ordered](slice []T, k int) []T {
+	return TopKBy(slice, k, func(a, b T) bool {
+		return a > b
+	})
+}
+
 // Clamp 将给定值限制在一个区间内。
 func Clamp[T constraints.Ordered](value T, min T, max T) T {
 	return If(value < min, min).ElseIf(value > max, max).Else(value)
@@ -58,6 +68,22 @@ func Max[T constraints.Ordered](slice ...T) T {
 	return max
 }
 
+// MaxIndex 返回集合最大值的下标。
+// 如果集合的几个值等于最大值，则返回第一个这样的值的下标。当集合为空时返回 -1。
+func MaxIndex[T constraints.Ordered](slice ...T) int {
+	if len(slice) == 0 {
+		return -1
+	}
+
+	index := 0
+
+	for i := 1; i < len(slice); i++ {
+		index = Ternary(slice[i] > slice[index], i, index)
+	}
+
+	return index
+}
+
 // MaxBy 使用给定的比较函数搜索集合的最大值。
 // 如果集合的几个值等于最大值，则返回第一个这样的值。当集合为空时返回零值。
 //
@@ -95,6 +121,40 @@ func Min[T constraints.Ordered](slice ...T) T {
 	return min
 }
 
+// MinIndex 返回集合最小值的下标。
+// 如果集合的几个值等于最小值，则返回第一个这样的值的下标。当集合为空时返回 -1。
+func MinIndex[T constraints.Ordered](slice ...T) int {
+	if len(slice) == 0 {
+		return -1
+	}
+
+	index := 0
+
+	for i := 1; i < len(slice); i++ {
+		index = Ternary(slice[i] < slice[index], i, index)
+	}
+
+	return index
+}
+
+// MinMax 在一次遍历中搜索集合的最小值和最大值。当集合为空时返回零值。
+func MinMax[T constraints.Ordered](slice ...T) (T, T) {
+	if len(slice) == 0 {
+		return Empty[T](), Empty[T]()
+	}
+
+	min, max := slice[0], slice[0]
+
+	for i := 1; i < len(slice); i++ {
+		item := slice[i]
+
+		min = Ternary(item < min, item, min)
+		max = Ternary(item > max, item, max)
+	}
+
+	return min, max
+}
+
 // MinBy 使用给定的比较函数搜索集合的最小值。
 // 如果集合的几个值等于最小值，则返回第一个这样的值。当集合为空时返回零值。
 //
@@ -115,6 +175,48 @@ func MinBy[T any](comparison func(T, T) bool, slice ...T) T {
 	return min
 }
 
+// NthElement 返回集合从小到大排序后下标 n 处的元素，使用快速选择算法，不会修改原集合。
+// 当 n 超出切片边界时返回错误。
+func NthElement[T constraints.Ordered](slice []T, n int) (T, error) {
+	if n < 0 || n >= len(slice) {
+		return Empty[T](), fmt.Errorf("nthElement: %d out of slice bounds", n)
+	}
+
+	data := append([]T{}, slice...)
+	left, right := 0, len(data)-1
+
+	for left < right {
+		// 随机选取基准值并进行三路划分：[left, lt) < pivot，[lt, gt] == pivot，(gt, right] > pivot
+		pivot := data[left+rand.Intn(right-left+1)]
+		lt, i, gt := left, left, right
+
+		for i <= gt {
+			switch {
+			case data[i] < pivot:
+				data[lt], data[i] = data[i], data[lt]
+				lt++
+				i++
+			case data[i] > pivot:
+				data[i], data[gt] = data[gt], data[i]
+				gt--
+			default:
+				i++
+			}
+		}
+
+		switch {
+		case n < lt:
+			right = lt - 1
+		case n > gt:
+			left = gt + 1
+		default:
+			return data[n], nil
+		}
+	}
+
+	return data[n], nil
+}
+
 // Range 根据给定长度返回一个 int 数组。
 func Range(num int) []int {
 	length := If(num < 0, -num).Else(num)
@@ -192,3 +294,34 @@ func SumBy[T any, R constraints.Float | constraints.Integer | constraints.Comple
 	}
 	return sum
 }
+
+// TopK 返回集合中最大的 k 个元素，结果从大到小排列。
+func TopK[T constraints.Ordered](slice []T, k int) []T {
+	return TopKBy(slice, k, func(a, b T) bool {
+		return a < b
+	})
+}
+
+// TopKBy 使用给定的比较函数返回集合中最大的 k 个元素，结果从大到小排列。
+//
+// 比较函数，当传入的第一个参数小于第二个参数时返回 true。
+func TopKBy[T any](slice []T, k int, less func(T, T) bool) []T {
+	k = Clamp(k, 0, len(slice))
+
+	// 维护一个大小为 k 的小顶堆，堆顶为当前第 k 大的元素
+	h, _ := HeapFromSlice(slice[:k], less)
+
+	for _, item := range slice[k:] {
+		if top, ok := h.Peek(); ok && less(top, item) {
+			h.Pop()
+			h.Push(item)
+		}
+	}
+
+	result := h.Values()
+	sort.Slice(result, func(i, j int) bool {
+		return less(result[j], result[i])
+	})
+
+	return result
+}
@@ -210,3 +210,97 @@ func TestAbs(t *testing.T) {
 	assert.Equal(int64(1), Abs(int64(-1)))
 	assert.Equal(float32(1), Abs(float32(-1)))
 }
+
+func TestTopK(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := TopK([]int{3, 1, 4, 1, 5, 9, 2, 6}, 3)
+	result2 := TopK([]int{3, 1, 4}, 5)
+	result3 := TopK([]int{3, 1, 4}, 0)
+	result4 := TopK([]int{}, 2)
+
+	is.Equal(result1, []int{9, 6, 5})
+	is.Equal(result2, []int{4, 3, 1})
+	is.Equal(result3, []int{})
+	is.Equal(result4, []int{})
+}
+
+func TestTopKBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := TopKBy([]string{"s", "string", "str", "strings"}, 2, func(a, b string) bool {
+		return len(a) < len(b)
+	})
+
+	is.Equal(result1, []string{"strings", "string"})
+}
+
+func TestBottomK(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := BottomK([]int{3, 1, 4, 1, 5, 9, 2, 6}, 3)
+	result2 := BottomK([]int{3, 1, 4}, -1)
+
+	is.Equal(result1, []int{1, 1, 2})
+	is.Equal(result2, []int{})
+}
+
+func TestNthElement(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	slice := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	sorted := []int{1, 1, 2, 3, 4, 5, 6, 9}
+
+	for i, expected := range sorted {
+		result, err := NthElement(slice, i)
+		is.Nil(err)
+		is.Equal(expected, result)
+	}
+	is.Equal([]int{3, 1, 4, 1, 5, 9, 2, 6}, slice)
+
+	_, err1 := NthElement(slice, 8)
+	_, err2 := NthElement(slice, -1)
+	_, err3 := NthElement([]int{}, 0)
+
+	is.NotNil(err1)
+	is.NotNil(err2)
+	is.NotNil(err3)
+}
+
+func TestMaxIndex(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(2, MaxIndex(1, 2, 3, 3))
+	is.Equal(0, MaxIndex("c", "b", "a"))
+	is.Equal(-1, MaxIndex[int]())
+}
+
+func TestMinIndex(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(1, MinIndex(2, 1, 1, 3))
+	is.Equal(2, MinIndex("c", "b", "a"))
+	is.Equal(-1, MinIndex[int]())
+}
+
+func TestMinMax(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	min1, max1 := MinMax(3, 1, 4, 1, 5)
+	min2, max2 := MinMax("b")
+	min3, max3 := MinMax[int]()
+
+	is.Equal(1, min1)
+	is.Equal(5, max1)
+	is.Equal("b", min2)
+	is.Equal("b", max2)
+	is.Equal(0, min3)
+	is.Equal(0, max3)
+}
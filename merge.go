@@ -0,0 +1,322 @@
+package kit
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// SliceMergeStrategy 深度合并时切片的合并策略。
+type SliceMergeStrategy int
+
+const (
+	// SliceReplace 使用 src 中的切片替换 dst 中的切片。
+	SliceReplace SliceMergeStrategy = iota
+	// SliceAppend 将 src 中的切片追加到 dst 中的切片之后。
+	SliceAppend
+	// SliceUnion 合并两个切片并去除重复的元素，等价于 Union。
+	SliceUnion
+)
+
+// MergeOptions 深度合并的选项。
+type MergeOptions struct {
+	// Slices 切片的合并策略，默认为 SliceReplace。
+	Slices SliceMergeStrategy
+	// OverwriteWithNil 为 true 时 src 中的 nil 值会覆盖 dst 中的值，否则会被忽略。
+	// 结构体中的零值字段与 nil 值相同对待。
+	OverwriteWithNil bool
+	// Resolver 自定义某个路径上的合并方式，路径格式为 "a.b.c"。
+	// 第二个返回值为 false 时使用默认的合并方式。
+	Resolver func(path string, dst any, src any) (any, bool, error)
+}
+
+// DeepMerge 使用默认选项将 srcs 从左到右递归合并到 dst 中。
+// 参考 DeepMergeWithOptions。
+func DeepMerge(dst any, srcs ...any) error {
+	return DeepMergeWithOptions(MergeOptions{}, dst, srcs...)
+}
+
+// DeepMergeWithOptions 将 srcs 从左到右递归合并到 dst 中。
+// dst 必须是非 nil 的 map 或者指向结构体、map 的指针，srcs 的类型需要与 dst 或 dst 指向的类型相同。
+// 嵌套的 map、结构体和指针会被递归合并，其他类型的值由 src 覆盖 dst。
+// 同一路径上 dst 与 src 的类型不同时返回错误。
+func DeepMergeWithOptions(options MergeOptions, dst any, srcs ...any) error {
+	dv := reflect.ValueOf(dst)
+
+	switch {
+	case dv.Kind() == reflect.Map && !dv.IsNil():
+	case dv.Kind() == reflect.Pointer && !dv.IsNil():
+		dv = dv.Elem()
+	default:
+		return fmt.Errorf("deepMerge: dst must be a non-nil map or pointer, got %T", dst)
+	}
+
+	m := &merger{options: options}
+
+	for _, src := range srcs {
+		sv := reflect.ValueOf(src)
+		if !sv.IsValid() {
+			continue
+		}
+		if sv.Kind() == reflect.Pointer && sv.Type() != dv.Type() {
+			if sv.IsNil() {
+				continue
+			}
+			sv = sv.Elem()
+		}
+
+		if sv.Type() != dv.Type() {
+			return fmt.Errorf("deepMerge: cannot merge %T into %T", src, dst)
+		}
+
+		// 指针指向的 nil map 需要先分配，不能直接使用 src 避免与 src 共享同一个 map
+		if dv.Kind() == reflect.Map && dv.IsNil() {
+			dv.Set(reflect.MakeMapWithSize(dv.Type(), sv.Len()))
+		}
+
+		merged, err := m.merge("", dv.Type(), dv, sv, false)
+		if err != nil {
+			return err
+		}
+
+		if dv.Kind() == reflect.Map {
+			iter := merged.MapRange()
+			for iter.Next() {
+				dv.SetMapIndex(iter.Key(), iter.Value())
+			}
+		} else {
+			dv.Set(merged)
+		}
+	}
+
+	return nil
+}
+
+type merger struct {
+	options MergeOptions
+}
+
+// merge 返回 dst 与 src 合并后的值，返回值可以赋值给 typ 类型。
+// field 表示当前的值是否为结构体字段。
+func (m *merger) merge(path string, typ reflect.Type, dst, src reflect.Value, field bool) (reflect.Value, error) {
+	if m.options.Resolver != nil {
+		result, ok, err := m.options.Resolver(path, valueInterface(dst), valueInterface(src))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if ok {
+			return convertValue(path, typ, result)
+		}
+	}
+
+	d, s := unwrapInterface(dst), unwrapInterface(src)
+
+	if isEmptyValue(s, field) {
+		if m.options.OverwriteWithNil {
+			return zeroIfInvalid(typ, src), nil
+		}
+		return dst, nil
+	}
+
+	if isEmptyValue(d, false) {
+		return src, nil
+	}
+
+	if d.Type() != s.Type() {
+		return reflect.Value{}, fmt.Errorf("deepMerge: type conflict at %q: %s and %s", path, d.Type(), s.Type())
+	}
+
+	var (
+		result reflect.Value
+		err    error
+	)
+
+	switch s.Kind() {
+	case reflect.Map:
+		result, err = m.mergeMap(path, d, s)
+	case reflect.Struct:
+		result, err = m.mergeStruct(path, d, s)
+	case reflect.Pointer:
+		var elem reflect.Value
+		elem, err = m.merge(path, s.Type().Elem(), d.Elem(), s.Elem(), false)
+		if err == nil {
+			result = reflect.New(s.Type().Elem())
+			result.Elem().Set(elem)
+		}
+	case reflect.Slice:
+		result = m.mergeSlice(d, s)
+	default:
+		result = s
+	}
+
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	// 保持接口类型的值可以赋值给原来的位置
+	if typ.Kind() == reflect.Interface {
+		return result, nil
+	}
+	return result.Convert(typ), nil
+}
+
+func (m *merger) mergeMap(path string, dst, src reflect.Value) (reflect.Value, error) {
+	result := reflect.MakeMapWithSize(dst.Type(), dst.Len()+src.Len())
+	elemType := dst.Type().Elem()
+
+	iter := dst.MapRange()
+	for iter.Next() {
+		result.SetMapIndex(iter.Key(), iter.Value())
+	}
+
+	iter = src.MapRange()
+	for iter.Next() {
+		key := iter.Key()
+
+		merged, err := m.merge(joinMergePath(path, fmt.Sprint(key.Interface())), elemType, dst.MapIndex(key), iter.Value(), false)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		// dst 中不存在且被忽略的 nil 值不写入结果
+		if merged.IsValid() {
+			result.SetMapIndex(key, merged)
+		}
+	}
+
+	return result, nil
+}
+
+func (m *merger) mergeStruct(path string, dst, src reflect.Value) (reflect.Value, error) {
+	result := reflect.New(dst.Type()).Elem()
+	result.Set(dst)
+
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		merged, err := m.merge(joinMergePath(path, field.Name), field.Type, dst.Field(i), src.Field(i), true)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Field(i).Set(merged)
+	}
+
+	return result, nil
+}
+
+func (m *merger) mergeSlice(dst, src reflect.Value) reflect.Value {
+	switch m.options.Slices {
+	case SliceAppend:
+		return reflect.AppendSlice(reflect.AppendSlice(reflect.MakeSlice(dst.Type(), 0, dst.Len()+src.Len()), dst), src)
+	case SliceUnion:
+		return unionSlice(dst, src)
+	default:
+		return src
+	}
+}
+
+// unionSlice 合并两个切片并去除重复的元素。
+// 元素均可比较时使用 Union，否则使用 reflect.DeepEqual 判断是否重复。
+func unionSlice(dst, src reflect.Value) reflect.Value {
+	items := make([]any, 0, dst.Len()+src.Len())
+	comparable := true
+
+	for _, slice := range []reflect.Value{dst, src} {
+		for i := 0; i < slice.Len(); i++ {
+			item := slice.Index(i)
+			comparable = comparable && item.Comparable()
+			items = append(items, item.Interface())
+		}
+	}
+
+	if comparable {
+		items = Union(items)
+	} else {
+		items = uniqueByDeepEqual(items)
+	}
+
+	result := reflect.MakeSlice(dst.Type(), 0, len(items))
+	for _, item := range items {
+		result = reflect.Append(result, zeroIfInvalid(dst.Type().Elem(), reflect.ValueOf(item)))
+	}
+
+	return result
+}
+
+// uniqueByDeepEqual 使用 reflect.DeepEqual 对集合进行去重，适用于元素不可比较的集合。
+func uniqueByDeepEqual[T any](slice []T) []T {
+	result := make([]T, 0, len(slice))
+
+	for _, item := range slice {
+		if !ContainBy(result, func(r T) bool { return reflect.DeepEqual(r, item) }) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+func joinMergePath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// unwrapInterface 取出接口中保存的值。
+func unwrapInterface(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+// isEmptyValue 判断值是否为 nil，field 为 true 时零值也视为空。
+func isEmptyValue(v reflect.Value, field bool) bool {
+	if !v.IsValid() {
+		return true
+	}
+
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Pointer, reflect.Interface, reflect.Func, reflect.Chan:
+		if v.IsNil() {
+			return true
+		}
+	}
+
+	return field && v.IsZero()
+}
+
+func valueInterface(v reflect.Value) any {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func zeroIfInvalid(typ reflect.Type, v reflect.Value) reflect.Value {
+	if !v.IsValid() {
+		return reflect.Zero(typ)
+	}
+	return v
+}
+
+// convertValue 将自定义合并函数返回的值转换为 typ 类型。
+func convertValue(path string, typ reflect.Type, value any) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+
+	switch {
+	case !v.IsValid():
+		return reflect.Zero(typ), nil
+	case v.Type().AssignableTo(typ):
+		return v, nil
+	case v.CanConvert(typ):
+		// CanConvert 同时检查切片转换为数组时的长度
+		return v.Convert(typ), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("deepMerge: resolver returned %T for %q, want %s", value, path, typ)
+	}
+}
@@ -0,0 +1,218 @@
+package kit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepMergeMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dst := map[string]any{
+		"name": "app",
+		"server": map[string]any{
+			"host": "localhost",
+			"port": 8080,
+		},
+		"tags": []any{"a", "b"},
+	}
+
+	err := DeepMerge(dst, map[string]any{
+		"server": map[string]any{
+			"port":    9090,
+			"timeout": "3s",
+		},
+		"tags":  []any{"c"},
+		"debug": true,
+	}, map[string]any{
+		"name": nil,
+		"log":  nil,
+	})
+
+	is.Nil(err)
+	is.Equal(map[string]any{
+		"name": "app",
+		"server": map[string]any{
+			"host":    "localhost",
+			"port":    9090,
+			"timeout": "3s",
+		},
+		"tags":  []any{"c"},
+		"debug": true,
+	}, dst)
+}
+
+func TestDeepMergeSlices(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	newDst := func() map[string]any {
+		return map[string]any{
+			"tags":  []any{"a", "b"},
+			"items": []any{map[string]any{"id": 1}},
+		}
+	}
+	src := map[string]any{
+		"tags":  []any{"b", "c"},
+		"items": []any{map[string]any{"id": 1}, map[string]any{"id": 2}},
+	}
+
+	dst := newDst()
+	is.Nil(DeepMergeWithOptions(MergeOptions{Slices: SliceAppend}, dst, src))
+	is.Equal([]any{"a", "b", "b", "c"}, dst["tags"])
+	is.Len(dst["items"], 3)
+
+	dst = newDst()
+	is.Nil(DeepMergeWithOptions(MergeOptions{Slices: SliceUnion}, dst, src))
+	is.Equal([]any{"a", "b", "c"}, dst["tags"])
+	is.Equal([]any{map[string]any{"id": 1}, map[string]any{"id": 2}}, dst["items"])
+
+	typed := map[string][]int{"a": {1, 2}}
+	is.Nil(DeepMergeWithOptions(MergeOptions{Slices: SliceUnion}, typed, map[string][]int{"a": {2, 3}, "b": {4}}))
+	is.Equal(map[string][]int{"a": {1, 2, 3}, "b": {4}}, typed)
+}
+
+func TestDeepMergeNil(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dst := map[string]any{"a": 1, "b": map[string]any{"c": 2}}
+	err := DeepMergeWithOptions(MergeOptions{OverwriteWithNil: true}, dst, map[string]any{
+		"a": nil,
+		"b": map[string]any{"c": nil},
+		"d": nil,
+	})
+
+	is.Nil(err)
+	is.Equal(map[string]any{"a": nil, "b": map[string]any{"c": nil}, "d": nil}, dst)
+
+	is.Nil(DeepMerge(dst, nil))
+
+	var empty map[string]any
+	src := map[string]any{"a": 1}
+	is.Nil(DeepMerge(&empty, nil))
+	is.Nil(empty)
+	is.Nil(DeepMerge(&empty, src, map[string]any{"b": 2}))
+	is.Equal(map[string]any{"a": 1, "b": 2}, empty)
+	is.Equal(map[string]any{"a": 1}, src)
+}
+
+func TestDeepMergeStruct(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type database struct {
+		Host string
+		Port int
+	}
+	type config struct {
+		Name     string
+		Database *database
+		Labels   map[string]string
+		Retries  int
+		hidden   string
+	}
+
+	dst := config{
+		Name:     "app",
+		Database: &database{Host: "localhost", Port: 3306},
+		Labels:   map[string]string{"env": "dev"},
+		Retries:  3,
+		hidden:   "dst",
+	}
+	origin := dst.Database
+
+	err := DeepMerge(&dst, config{
+		Database: &database{Port: 3307},
+		Labels:   map[string]string{"team": "infra"},
+		hidden:   "src",
+	}, &config{Name: "service"})
+
+	is.Nil(err)
+	is.Equal("service", dst.Name)
+	is.Equal(&database{Host: "localhost", Port: 3307}, dst.Database)
+	is.Equal(map[string]string{"env": "dev", "team": "infra"}, dst.Labels)
+	is.Equal(3, dst.Retries)
+	is.Equal("dst", dst.hidden)
+
+	// 不会修改原有的指针指向的值
+	is.Equal(3306, origin.Port)
+
+	is.Nil(DeepMergeWithOptions(MergeOptions{OverwriteWithNil: true}, &dst, config{Name: "new"}))
+	is.Equal(config{Name: "new", hidden: "dst"}, dst)
+}
+
+func TestDeepMergeResolver(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	paths := []string{}
+	options := MergeOptions{
+		Resolver: func(path string, dst any, src any) (any, bool, error) {
+			paths = append(paths, path)
+
+			if path == "server.port" {
+				return ToInt(dst) + ToInt(src), true, nil
+			}
+			return nil, false, nil
+		},
+	}
+
+	dst := map[string]any{"server": map[string]any{"port": 8000, "host": "a"}}
+	is.Nil(DeepMergeWithOptions(options, dst, map[string]any{"server": map[string]any{"port": 80, "host": "b"}}))
+
+	is.Equal(map[string]any{"server": map[string]any{"port": 8080, "host": "b"}}, dst)
+	is.ElementsMatch([]string{"", "server", "server.port", "server.host"}, paths)
+
+	type config struct {
+		Port int
+	}
+
+	c := config{Port: 1}
+	is.Nil(DeepMergeWithOptions(MergeOptions{
+		Resolver: func(path string, dst any, src any) (any, bool, error) {
+			return int64(100), path == "Port", nil
+		},
+	}, &c, config{Port: 2}))
+	is.Equal(100, c.Port)
+
+	is.NotNil(DeepMergeWithOptions(MergeOptions{
+		Resolver: func(path string, dst any, src any) (any, bool, error) {
+			return "x", path == "Port", nil
+		},
+	}, &c, config{Port: 2}))
+
+	type vector struct {
+		Values [3]int
+	}
+
+	v := vector{}
+	err := DeepMergeWithOptions(MergeOptions{
+		Resolver: func(path string, dst any, src any) (any, bool, error) {
+			return []int{1}, path == "Values", nil
+		},
+	}, &v, vector{Values: [3]int{1, 2, 3}})
+	is.EqualError(err, `deepMerge: resolver returned []int for "Values", want [3]int`)
+
+	is.Nil(DeepMergeWithOptions(MergeOptions{
+		Resolver: func(path string, dst any, src any) (any, bool, error) {
+			return []int{4, 5, 6}, path == "Values", nil
+		},
+	}, &v, vector{Values: [3]int{1, 2, 3}}))
+	is.Equal([3]int{4, 5, 6}, v.Values)
+}
+
+func TestDeepMergeError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	err := DeepMerge(map[string]any{"a": map[string]any{"b": 1}}, map[string]any{"a": map[string]any{"b": "1"}})
+	is.EqualError(err, `deepMerge: type conflict at "a.b": int and string`)
+
+	is.NotNil(DeepMerge(map[string]any{"a": 1}, map[string]int{"a": 1}))
+	is.NotNil(DeepMerge(nil, map[string]any{}))
+	is.NotNil(DeepMerge(map[string]any(nil), map[string]any{}))
+	is.NotNil(DeepMerge(struct{}{}, struct{}{}))
+}
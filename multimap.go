@@ -0,0 +1,131 @@
+package kit
+
+// MultiMap 一个键可以对应多个值的映射。零值可以直接使用，具有列表语义。
+type MultiMap[K comparable, V comparable] struct {
+	items map[K][]V
+	// unique 为 true 时同一个键下的值不能重复
+	unique bool
+	size   int
+}
+
+// NewListMultiMap 创建具有列表语义的多值映射，同一个键下可以保存重复的值，并保持插入顺序。
+func NewListMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{items: make(map[K][]V)}
+}
+
+// NewSetMultiMap 创建具有集合语义的多值映射，同一个键下的值不会重复，并保持插入顺序。
+func NewSetMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{items: make(map[K][]V), unique: true}
+}
+
+// Put 向键中添加一个值，返回是否添加成功。集合语义下值已经存在时返回 false。
+func (m *MultiMap[K, V]) Put(key K, value V) bool {
+	if m.unique && m.HasEntry(key, value) {
+		return false
+	}
+
+	if m.items == nil {
+		m.items = make(map[K][]V)
+	}
+
+	m.items[key] = append(m.items[key], value)
+	m.size++
+
+	return true
+}
+
+// PutAll 向键中添加多个值，返回添加成功的个数。
+func (m *MultiMap[K, V]) PutAll(key K, values ...V) int {
+	return CountBy(values, func(_ int, value V) bool {
+		return m.Put(key, value)
+	})
+}
+
+// Get 返回键对应的所有值的拷贝，键不存在时返回空切片。
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return append([]V{}, m.items[key]...)
+}
+
+// Has 判断键是否存在。
+func (m *MultiMap[K, V]) Has(key K) bool {
+	_, ok := m.items[key]
+	return ok
+}
+
+// HasEntry 判断键值对是否存在。
+func (m *MultiMap[K, V]) HasEntry(key K, value V) bool {
+	return Contain(m.items[key], value)
+}
+
+// Remove 删除键下的一个值，返回值是否存在。列表语义下只删除第一个相等的值。
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	values := m.items[key]
+
+	i := IndexOf(values, value)
+	if i < 0 {
+		return false
+	}
+
+	if len(values) == 1 {
+		delete(m.items, key)
+	} else {
+		m.items[key] = append(values[:i:i], values[i+1:]...)
+	}
+	m.size--
+
+	return true
+}
+
+// RemoveAll 删除键及其对应的所有值，返回被删除的值。
+func (m *MultiMap[K, V]) RemoveAll(key K) []V {
+	values := m.items[key]
+
+	delete(m.items, key)
+	m.size -= len(values)
+
+	return append([]V{}, values...)
+}
+
+// Len 返回键值对的个数。
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// KeyLen 返回键的个数。
+func (m *MultiMap[K, V]) KeyLen() int {
+	return len(m.items)
+}
+
+// Keys 返回所有的键，顺序是随机的。
+func (m *MultiMap[K, V]) Keys() []K {
+	return Keys(m.items)
+}
+
+// Values 返回所有的值，不同键之间的顺序是随机的。
+func (m *MultiMap[K, V]) Values() []V {
+	return Flatten(Values(m.items))
+}
+
+// Entries 返回所有的键值对，不同键之间的顺序是随机的。
+func (m *MultiMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.size)
+
+	for k, values := range m.items {
+		for _, v := range values {
+			entries = append(entries, Entry[K, V]{Key: k, Value: v})
+		}
+	}
+
+	return entries
+}
+
+// ToMap 返回从键到值切片的 map 拷贝。
+func (m *MultiMap[K, V]) ToMap() map[K][]V {
+	result := make(map[K][]V, len(m.items))
+
+	for k, values := range m.items {
+		result[k] = append([]V{}, values...)
+	}
+
+	return result
+}
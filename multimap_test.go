@@ -0,0 +1,75 @@
+package kit
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMultiMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewListMultiMap[string, int]()
+	is.True(m.Put("a", 1))
+	is.True(m.Put("a", 1))
+	is.Equal(2, m.PutAll("b", 2, 3))
+
+	is.Equal(4, m.Len())
+	is.Equal(2, m.KeyLen())
+	is.Equal([]int{1, 1}, m.Get("a"))
+	is.Equal([]int{}, m.Get("c"))
+
+	is.True(m.Has("a"))
+	is.True(m.HasEntry("b", 3))
+	is.False(m.HasEntry("b", 1))
+
+	is.True(m.Remove("a", 1))
+	is.Equal([]int{1}, m.Get("a"))
+	is.True(m.Remove("a", 1))
+	is.False(m.Has("a"))
+	is.False(m.Remove("a", 1))
+
+	is.Equal([]int{2, 3}, m.RemoveAll("b"))
+	is.Equal(0, m.Len())
+	is.Equal(0, m.KeyLen())
+}
+
+func TestSetMultiMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewSetMultiMap[string, int]()
+	is.True(m.Put("a", 1))
+	is.False(m.Put("a", 1))
+	is.Equal(2, m.PutAll("a", 3, 2, 3))
+	is.Equal(1, m.PutAll("b", 1, 1))
+
+	is.Equal(4, m.Len())
+	is.Equal([]int{1, 3, 2}, m.Get("a"))
+	is.Equal(map[string][]int{"a": {1, 3, 2}, "b": {1}}, m.ToMap())
+
+	values := m.Values()
+	sort.Ints(values)
+	is.Equal([]int{1, 1, 2, 3}, values)
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	is.Equal([]string{"a", "b"}, keys)
+
+	is.ElementsMatch([]Entry[string, int]{{"a", 1}, {"a", 3}, {"a", 2}, {"b", 1}}, m.Entries())
+
+	is.True(m.Remove("a", 3))
+	is.Equal([]int{1, 2}, m.Get("a"))
+
+	// 返回的切片是拷贝
+	values = m.Get("a")
+	values[0] = 100
+	is.Equal([]int{1, 2}, m.Get("a"))
+
+	var zero MultiMap[int, int]
+	zero.Put(1, 1)
+	zero.Put(1, 1)
+	is.Equal([]int{1, 1}, zero.Get(1))
+}
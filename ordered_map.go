@@ -0,0 +1,278 @@
+package kit
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap 保持插入顺序的映射。零值可以直接使用。
+type OrderedMap[K comparable, V any] struct {
+	index map[K]*listNode[Entry[K, V]]
+	list  linkedList[Entry[K, V]]
+}
+
+// NewOrderedMap 使用给定的键值对按顺序创建映射。
+func NewOrderedMap[K comparable, V any](entries ...Entry[K, V]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{index: make(map[K]*listNode[Entry[K, V]], len(entries))}
+
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
+	}
+
+	return m
+}
+
+// Get 返回给定键的值，键不存在时返回零值和 false。
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if n, ok := m.index[key]; ok {
+		return n.value.Value, true
+	}
+	return Empty[V](), false
+}
+
+// Has 判断键是否存在。
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.index[key]
+	return ok
+}
+
+// Set 设置键值对。新的键追加到末尾，已存在的键只更新值，不改变位置。
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if n, ok := m.index[key]; ok {
+		n.value.Value = value
+		return
+	}
+
+	if m.index == nil {
+		m.index = make(map[K]*listNode[Entry[K, V]])
+	}
+	m.index[key] = m.list.pushBack(Entry[K, V]{Key: key, Value: value})
+}
+
+// Delete 删除给定的键，返回键是否存在。
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	n, ok := m.index[key]
+	if !ok {
+		return false
+	}
+
+	m.list.remove(n)
+	delete(m.index, key)
+
+	return true
+}
+
+// Len 返回键值对的个数。
+func (m *OrderedMap[K, V]) Len() int {
+	return m.list.len()
+}
+
+// MoveToFront 将给定的键移动到最前面，返回键是否存在。
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	n, ok := m.index[key]
+	if ok {
+		m.list.moveToFront(n)
+	}
+	return ok
+}
+
+// MoveToBack 将给定的键移动到最后面，返回键是否存在。
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	n, ok := m.index[key]
+	if ok {
+		m.list.moveToBack(n)
+	}
+	return ok
+}
+
+// Front 返回第一个键值对，映射为空时返回 false。
+func (m *OrderedMap[K, V]) Front() (Entry[K, V], bool) {
+	if n := m.list.front(); n != nil {
+		return n.value, true
+	}
+	return Entry[K, V]{}, false
+}
+
+// Back 返回最后一个键值对，映射为空时返回 false。
+func (m *OrderedMap[K, V]) Back() (Entry[K, V], bool) {
+	if n := m.list.back(); n != nil {
+		return n.value, true
+	}
+	return Entry[K, V]{}, false
+}
+
+// Range 按顺序遍历所有键值对，当 iteratee 返回 false 时停止遍历。
+// 可以在 iteratee 中删除当前遍历到的键。
+func (m *OrderedMap[K, V]) Range(iteratee func(key K, value V) bool) {
+	for n := m.list.front(); n != nil; {
+		next := m.list.nextOf(n)
+
+		if !iteratee(n.value.Key, n.value.Value) {
+			return
+		}
+
+		n = next
+	}
+}
+
+// Keys 按顺序返回所有的键。
+func (m *OrderedMap[K, V]) Keys() []K {
+	return Map(m.list.values(), func(_ int, entry Entry[K, V]) K {
+		return entry.Key
+	})
+}
+
+// Values 按顺序返回所有的值。
+func (m *OrderedMap[K, V]) Values() []V {
+	return Map(m.list.values(), func(_ int, entry Entry[K, V]) V {
+		return entry.Value
+	})
+}
+
+// Entries 按顺序返回所有的键值对。
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	return m.list.values()
+}
+
+// ToMap 将有序映射转换为普通的 map。
+func (m *OrderedMap[K, V]) ToMap() map[K]V {
+	return FromEntries(m.Entries())
+}
+
+// MarshalJSON 将映射按顺序序列化为 JSON 对象。
+// 键的类型需要是字符串、整数或实现了 encoding.TextMarshaler。
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for n := m.list.front(); n != nil; n = m.list.nextOf(n) {
+		if n != m.list.front() {
+			buf.WriteByte(',')
+		}
+
+		key, err := marshalMapKey(n.value.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		keyData, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		valueData, err := json.Marshal(n.value.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(valueData)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON 从 JSON 对象反序列化映射，并保持键在 JSON 中出现的顺序。
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.index = make(map[K]*listNode[Entry[K, V]])
+	m.list = linkedList[Entry[K, V]]{}
+
+	if string(bytes.TrimSpace(data)) == "null" {
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedMap: cannot unmarshal %v into object", token)
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, err := unmarshalMapKey[K](token.(string))
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+
+		m.Set(key, value)
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+// marshalMapKey 按照 encoding/json 对 map 键的规则将键转换为字符串，字符串类型的键直接使用，其次使用 MarshalText。
+func marshalMapKey[K comparable](key K) (string, error) {
+	v := reflect.ValueOf(key)
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported map key type %T", key)
+	}
+}
+
+// unmarshalMapKey 按照 encoding/json 对 map 键的规则将字符串转换为键，与 marshalMapKey 的顺序一致。
+func unmarshalMapKey[K comparable](s string) (K, error) {
+	var key K
+
+	v := reflect.ValueOf(&key).Elem()
+	if v.Kind() == reflect.String {
+		v.SetString(s)
+		return key, nil
+	}
+
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(s))
+		return key, err
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return key, fmt.Errorf("unable to convert %q to map key of type %T", s, key)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return key, fmt.Errorf("unable to convert %q to map key of type %T", s, key)
+		}
+		v.SetUint(n)
+	default:
+		return key, fmt.Errorf("unsupported map key type %T", key)
+	}
+
+	return key, nil
+}
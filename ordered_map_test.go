@@ -0,0 +1,182 @@
+package kit
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("a", 10)
+
+	is.Equal(3, m.Len())
+	is.Equal([]string{"c", "a", "b"}, m.Keys())
+	is.Equal([]int{3, 10, 2}, m.Values())
+
+	v, ok := m.Get("a")
+	is.Equal(10, v)
+	is.True(ok)
+
+	v, ok = m.Get("d")
+	is.Equal(0, v)
+	is.False(ok)
+
+	is.True(m.Has("b"))
+	is.True(m.Delete("b"))
+	is.False(m.Delete("b"))
+	is.False(m.Has("b"))
+
+	m.Set("b", 20)
+	is.Equal([]Entry[string, int]{{"c", 3}, {"a", 10}, {"b", 20}}, m.Entries())
+	is.Equal(map[string]int{"a": 10, "b": 20, "c": 3}, m.ToMap())
+}
+
+func TestOrderedMapMove(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewOrderedMap(Entry[string, int]{"a", 1}, Entry[string, int]{"b", 2}, Entry[string, int]{"c", 3})
+
+	is.True(m.MoveToFront("c"))
+	is.Equal([]string{"c", "a", "b"}, m.Keys())
+
+	is.True(m.MoveToBack("c"))
+	is.Equal([]string{"a", "b", "c"}, m.Keys())
+
+	is.True(m.MoveToBack("c"))
+	is.True(m.MoveToFront("a"))
+	is.Equal([]string{"a", "b", "c"}, m.Keys())
+
+	is.False(m.MoveToFront("d"))
+	is.False(m.MoveToBack("d"))
+
+	front, ok := m.Front()
+	is.True(ok)
+	is.Equal(Entry[string, int]{"a", 1}, front)
+
+	back, ok := m.Back()
+	is.True(ok)
+	is.Equal(Entry[string, int]{"c", 3}, back)
+
+	var empty OrderedMap[string, int]
+	_, ok = empty.Front()
+	is.False(ok)
+	_, ok = empty.Back()
+	is.False(ok)
+	is.False(empty.Delete("a"))
+	is.Equal([]string{}, empty.Keys())
+}
+
+func TestOrderedMapRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewOrderedMap(Entry[int, string]{3, "c"}, Entry[int, string]{1, "a"}, Entry[int, string]{2, "b"})
+
+	keys := []int{}
+	m.Range(func(key int, value string) bool {
+		keys = append(keys, key)
+		m.Delete(key)
+		return key != 1
+	})
+
+	is.Equal([]int{3, 1}, keys)
+	is.Equal([]int{2}, m.Keys())
+}
+
+type orderedMapTextKey string
+
+func (k orderedMapTextKey) MarshalText() ([]byte, error) {
+	return []byte(strings.ToUpper(string(k))), nil
+}
+
+func (k *orderedMapTextKey) UnmarshalText(text []byte) error {
+	*k = orderedMapTextKey(strings.ToLower(string(text)))
+	return nil
+}
+
+type orderedMapPointKey struct{ x, y int }
+
+func (k orderedMapPointKey) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(k.x) + "," + strconv.Itoa(k.y)), nil
+}
+
+func (k *orderedMapPointKey) UnmarshalText(text []byte) error {
+	x, y, _ := strings.Cut(string(text), ",")
+	k.x, _ = strconv.Atoi(x)
+	k.y, _ = strconv.Atoi(y)
+	return nil
+}
+
+func TestOrderedMapJSONTextKeys(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// 字符串类型的键直接使用，不调用 MarshalText 和 UnmarshalText
+	m := NewOrderedMap(Entry[orderedMapTextKey, int]{"b", 1}, Entry[orderedMapTextKey, int]{"A", 2})
+
+	data, err := json.Marshal(m)
+	is.Nil(err)
+	is.Equal(`{"b":1,"A":2}`, string(data))
+
+	var u OrderedMap[orderedMapTextKey, int]
+	is.Nil(json.Unmarshal(data, &u))
+	is.Equal([]orderedMapTextKey{"b", "A"}, u.Keys())
+
+	// 非字符串类型的键使用 MarshalText 和 UnmarshalText
+	p := NewOrderedMap(Entry[orderedMapPointKey, int]{orderedMapPointKey{1, 2}, 3})
+
+	data, err = json.Marshal(p)
+	is.Nil(err)
+	is.Equal(`{"1,2":3}`, string(data))
+
+	var q OrderedMap[orderedMapPointKey, int]
+	is.Nil(json.Unmarshal(data, &q))
+	is.Equal([]orderedMapPointKey{{1, 2}}, q.Keys())
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewOrderedMap[string, any]()
+	m.Set("z", 1)
+	m.Set("a", []int{1, 2})
+	m.Set("m", map[string]string{"k": "v"})
+
+	data, err := json.Marshal(m)
+	is.Nil(err)
+	is.Equal(`{"z":1,"a":[1,2],"m":{"k":"v"}}`, string(data))
+
+	var u OrderedMap[string, int]
+	is.Nil(json.Unmarshal([]byte(`{"z": 1, "a": 2, "m": 3, "a": 4}`), &u))
+	is.Equal([]Entry[string, int]{{"z", 1}, {"a", 4}, {"m", 3}}, u.Entries())
+
+	var n OrderedMap[int, bool]
+	is.Nil(json.Unmarshal([]byte(`{"3": true, "1": false}`), &n))
+	is.Equal([]int{3, 1}, n.Keys())
+
+	data, err = json.Marshal(&n)
+	is.Nil(err)
+	is.Equal(`{"3":true,"1":false}`, string(data))
+
+	is.NotNil(json.Unmarshal([]byte(`{"x": true}`), &n))
+	is.NotNil(json.Unmarshal([]byte(`[1]`), &n))
+	is.NotNil(json.Unmarshal([]byte(`{"1": "x"}`), &n))
+
+	is.Nil(json.Unmarshal([]byte(`null`), &n))
+	is.Equal(0, n.Len())
+
+	_, err = json.Marshal(NewOrderedMap(Entry[float64, int]{1.5, 1}))
+	is.NotNil(err)
+}
@@ -0,0 +1,599 @@
+package kit
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GetPath 根据路径获取嵌套数据中的值，路径不存在时返回 false。
+//
+// 路径由 "." 分隔的键和 "[n]" 形式的下标组成，例如 "a.b[0].c"。
+// 键中包含 "."、"[" 等特殊字符时，可以使用 "\" 进行转义，例如 `a\.b` 表示键 "a.b"。
+// 键也可以使用双引号包裹在方括号中，例如 `a["b.c"]`，空字符串键只能写作 `[""]`。
+// 支持遍历 map、切片、数组和结构体，结构体字段优先按 json 标签匹配，其次按字段名匹配。
+func GetPath(data any, path string) (any, bool) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, false
+	}
+
+	value := reflect.ValueOf(data)
+	for _, segment := range segments {
+		var ok bool
+		if value, ok = pathChild(value, segment); !ok {
+			return nil, false
+		}
+	}
+
+	return valueInterface(value), true
+}
+
+// GetPathAs 根据路径获取嵌套数据中的值，并使用 convert.go 中的函数将其转换为 T 类型。
+// 值为 nil 时返回 T 的零值，其他类型尝试使用反射进行转换。路径不存在或者无法转换时返回错误。参考 GetPath。
+func GetPathAs[T any](data any, path string) (T, error) {
+	var result T
+
+	value, ok := GetPath(data, path)
+	if !ok {
+		return result, fmt.Errorf("getPath: %q not found", path)
+	}
+
+	if value == nil {
+		return result, nil
+	}
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	var converted any
+	var err error
+
+	switch any(result).(type) {
+	case bool:
+		converted, err = ToBoolE(value)
+	case int:
+		converted, err = ToIntE(value)
+	case int8:
+		converted, err = ToInt8E(value)
+	case int16:
+		converted, err = ToInt16E(value)
+	case int32:
+		converted, err = ToInt32E(value)
+	case int64:
+		converted, err = ToInt64E(value)
+	case uint:
+		converted, err = ToUintE(value)
+	case uint8:
+		converted, err = ToUint8E(value)
+	case uint16:
+		converted, err = ToUint16E(value)
+	case uint32:
+		converted, err = ToUint32E(value)
+	case uint64:
+		converted, err = ToUint64E(value)
+	case float32:
+		converted, err = ToFloat32E(value)
+	case float64:
+		converted, err = ToFloat64E(value)
+	case string:
+		converted = ToString(value)
+	case time.Duration:
+		converted, err = ToDurationE(value)
+	case time.Time:
+		converted, err = ToTimeE(value)
+	default:
+		// 其他类型，例如以基础类型定义的命名类型，尝试使用反射进行转换
+		v, ok := convertPathValue(reflect.TypeOf(&result).Elem(), value)
+		if !ok {
+			return result, fmt.Errorf("getPath: unable to convert %T to %T at %q", value, result, path)
+		}
+		return v.Interface().(T), nil
+	}
+
+	if err != nil {
+		return result, fmt.Errorf("getPath: %q: %w", path, err)
+	}
+
+	return converted.(T), nil
+}
+
+// HasPath 判断嵌套数据中是否存在给定的路径。参考 GetPath。
+func HasPath(data any, path string) bool {
+	_, ok := GetPath(data, path)
+	return ok
+}
+
+// SetPath 根据路径设置嵌套数据中的值，data 必须是非 nil 的 map 或者指针。
+//
+// 路径上缺失的中间节点会被自动创建：接口类型的节点按下一段路径创建 map[string]any 或 []any，
+// 其他类型的节点按其自身类型创建。下标等于切片长度时会在末尾追加元素。
+// 值的类型与目标位置不一致时会尝试进行类型转换，无法转换时返回错误。参考 GetPath。
+func SetPath(data any, path string, value any) error {
+	segments, err := parsePath(path)
+	if err != nil {
+		return err
+	}
+	if len(segments) == 0 {
+		return fmt.Errorf("setPath: empty path")
+	}
+
+	root := reflect.ValueOf(data)
+	if !isPathRoot(root) {
+		return fmt.Errorf("setPath: data must be a non-nil map or pointer, got %T", data)
+	}
+
+	_, err = setPathValue(root, root.Type(), segments, value)
+	return err
+}
+
+// DeletePath 根据路径删除嵌套数据中的值，data 必须是非 nil 的 map 或者指针。
+// map 中的键会被删除，切片中的元素会被移除，数组元素和结构体字段会被置为零值。
+// 路径存在并且删除成功时返回 true。参考 GetPath。
+func DeletePath(data any, path string) bool {
+	segments, err := parsePath(path)
+	if err != nil || len(segments) == 0 {
+		return false
+	}
+
+	root := reflect.ValueOf(data)
+	if !isPathRoot(root) {
+		return false
+	}
+
+	_, ok := deletePathValue(root, segments)
+	return ok
+}
+
+// pathSegment 路径中的一段，可以是键或者下标。
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// position 返回路径段对应的切片下标，键为非负整数时同样视为下标。
+func (s pathSegment) position() (int, bool) {
+	if s.isIndex {
+		return s.index, true
+	}
+
+	index, err := strconv.Atoi(s.key)
+	return index, err == nil && index >= 0
+}
+
+// text 返回路径段对应的 map 键文本。
+func (s pathSegment) text() string {
+	if s.isIndex {
+		return strconv.Itoa(s.index)
+	}
+	return s.key
+}
+
+// parsePath 将路径解析为路径段。
+func parsePath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	var key strings.Builder
+
+	hasKey, afterIndex, needKey := false, false, false
+
+	flush := func() {
+		segments = append(segments, pathSegment{key: key.String()})
+		key.Reset()
+		hasKey, needKey = false, false
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; c {
+		case '\\':
+			if i+1 >= len(path) || afterIndex {
+				return nil, fmt.Errorf("path: invalid escape at %d in %q", i, path)
+			}
+			i++
+			key.WriteByte(path[i])
+			hasKey = true
+		case '.':
+			if !hasKey && !afterIndex {
+				return nil, fmt.Errorf("path: empty key at %d in %q", i, path)
+			}
+			if hasKey {
+				flush()
+			}
+			afterIndex, needKey = false, true
+		case '[':
+			if hasKey {
+				flush()
+			} else if needKey {
+				return nil, fmt.Errorf("path: empty key at %d in %q", i, path)
+			}
+
+			// 使用双引号包裹的键，例如 [""] 表示空字符串键
+			if strings.HasPrefix(path[i+1:], `"`) {
+				quoted, err := strconv.QuotedPrefix(path[i+1:])
+				if err != nil || !strings.HasPrefix(path[i+1+len(quoted):], "]") {
+					return nil, fmt.Errorf("path: invalid quoted key at %d in %q", i, path)
+				}
+
+				key, _ := strconv.Unquote(quoted)
+				segments = append(segments, pathSegment{key: key})
+				afterIndex = true
+				i += len(quoted) + 1
+				continue
+			}
+
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("path: unclosed '[' at %d in %q", i, path)
+			}
+
+			index, err := strconv.Atoi(path[i+1 : i+end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("path: invalid index %q in %q", path[i+1:i+end], path)
+			}
+
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+			afterIndex = true
+			i += end
+		default:
+			if afterIndex {
+				return nil, fmt.Errorf("path: unexpected %q at %d in %q", c, i, path)
+			}
+			key.WriteByte(c)
+			hasKey = true
+		}
+	}
+
+	if hasKey {
+		flush()
+	} else if needKey {
+		return nil, fmt.Errorf("path: empty key at end of %q", path)
+	}
+
+	return segments, nil
+}
+
+func isPathRoot(root reflect.Value) bool {
+	return root.IsValid() && (root.Kind() == reflect.Map || root.Kind() == reflect.Pointer) && !root.IsNil()
+}
+
+// indirectValue 取出接口和指针指向的值，遇到 nil 时返回无效值。
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// pathChild 返回 v 中路径段对应的子节点。
+func pathChild(v reflect.Value, segment pathSegment) (reflect.Value, bool) {
+	v = indirectValue(v)
+	if !v.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		key, ok := pathMapKey(v.Type().Key(), segment)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		child := v.MapIndex(key)
+		return child, child.IsValid()
+	case reflect.Slice, reflect.Array:
+		index, ok := segment.position()
+		if !ok || index >= v.Len() {
+			return reflect.Value{}, false
+		}
+		return v.Index(index), true
+	case reflect.Struct:
+		index, ok := pathFieldIndex(v.Type(), segment)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		return v.Field(index), true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// pathMapKey 将路径段转换为 typ 类型的 map 键。
+func pathMapKey(typ reflect.Type, segment pathSegment) (reflect.Value, bool) {
+	text := segment.text()
+	key := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.String:
+		key.SetString(text)
+	case reflect.Interface:
+		if !reflect.TypeOf(text).AssignableTo(typ) {
+			return reflect.Value{}, false
+		}
+		key.Set(reflect.ValueOf(text))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		key.SetUint(n)
+	default:
+		return reflect.Value{}, false
+	}
+
+	return key, true
+}
+
+// pathFieldIndex 返回路径段对应的结构体导出字段下标，优先匹配 json 标签，其次匹配字段名。
+func pathFieldIndex(typ reflect.Type, segment pathSegment) (int, bool) {
+	if segment.isIndex {
+		return 0, false
+	}
+
+	byName := -1
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == "-" {
+			continue
+		}
+		if tag == segment.key {
+			return i, true
+		}
+		if byName < 0 && field.Name == segment.key {
+			byName = i
+		}
+	}
+
+	return byName, byName >= 0
+}
+
+// newPathContainer 根据路径段为接口类型的节点创建容器。
+func newPathContainer(segment pathSegment) reflect.Value {
+	if segment.isIndex {
+		return reflect.ValueOf([]any{})
+	}
+	return reflect.ValueOf(map[string]any{})
+}
+
+// setPathValue 返回将 value 设置到 cur 中后的值，返回值可以赋值给 typ 类型。
+func setPathValue(cur reflect.Value, typ reflect.Type, segments []pathSegment, value any) (reflect.Value, error) {
+	if len(segments) == 0 {
+		converted, ok := convertPathValue(typ, value)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("setPath: cannot use %T as %s", value, typ)
+		}
+		return converted, nil
+	}
+
+	segment := segments[0]
+
+	if typ.Kind() == reflect.Interface {
+		cur = unwrapInterface(cur)
+		if !cur.IsValid() {
+			cur = newPathContainer(segment)
+		}
+		typ = cur.Type()
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		if !cur.IsValid() || cur.IsNil() {
+			cur = reflect.New(typ.Elem())
+		}
+
+		elem, err := setPathValue(cur.Elem(), typ.Elem(), segments, value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		cur.Elem().Set(elem)
+		return cur, nil
+	case reflect.Map:
+		if !cur.IsValid() || cur.IsNil() {
+			cur = reflect.MakeMap(typ)
+		}
+
+		key, ok := pathMapKey(typ.Key(), segment)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("setPath: invalid key %q for %s", segment.text(), typ)
+		}
+
+		child, err := setPathValue(cur.MapIndex(key), typ.Elem(), segments[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		cur.SetMapIndex(key, child)
+		return cur, nil
+	case reflect.Slice:
+		if !cur.IsValid() {
+			cur = reflect.Zero(typ)
+		}
+
+		index, ok := segment.position()
+		if !ok || index > cur.Len() {
+			return reflect.Value{}, fmt.Errorf("setPath: index %q out of range for %s of length %d", segment.text(), typ, cur.Len())
+		}
+		if index == cur.Len() {
+			cur = reflect.Append(cur, reflect.Zero(typ.Elem()))
+		}
+
+		child, err := setPathValue(cur.Index(index), typ.Elem(), segments[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		cur.Index(index).Set(child)
+		return cur, nil
+	case reflect.Array:
+		index, ok := segment.position()
+		if !ok || index >= typ.Len() {
+			return reflect.Value{}, fmt.Errorf("setPath: index %q out of range for %s", segment.text(), typ)
+		}
+
+		result := copyPathValue(typ, cur)
+
+		child, err := setPathValue(result.Index(index), typ.Elem(), segments[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Index(index).Set(child)
+		return result, nil
+	case reflect.Struct:
+		index, ok := pathFieldIndex(typ, segment)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("setPath: unknown field %q in %s", segment.text(), typ)
+		}
+
+		result := copyPathValue(typ, cur)
+
+		child, err := setPathValue(result.Field(index), typ.Field(index).Type, segments[1:], value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Field(index).Set(child)
+		return result, nil
+	default:
+		return reflect.Value{}, fmt.Errorf("setPath: cannot traverse %s with %q", typ, segment.text())
+	}
+}
+
+// deletePathValue 返回删除路径后 cur 的值，路径不存在时返回 false。
+func deletePathValue(cur reflect.Value, segments []pathSegment) (reflect.Value, bool) {
+	cur = unwrapInterface(cur)
+	if !cur.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	segment, last := segments[0], len(segments) == 1
+
+	switch cur.Kind() {
+	case reflect.Pointer:
+		if cur.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		elem, ok := deletePathValue(cur.Elem(), segments)
+		if ok {
+			cur.Elem().Set(elem)
+		}
+		return cur, ok
+	case reflect.Map:
+		key, ok := pathMapKey(cur.Type().Key(), segment)
+		if !ok {
+			return reflect.Value{}, false
+		}
+
+		child := cur.MapIndex(key)
+		if !child.IsValid() {
+			return reflect.Value{}, false
+		}
+
+		if last {
+			cur.SetMapIndex(key, reflect.Value{})
+			return cur, true
+		}
+
+		child, ok = deletePathValue(child, segments[1:])
+		if ok {
+			cur.SetMapIndex(key, child)
+		}
+		return cur, ok
+	case reflect.Slice:
+		index, ok := segment.position()
+		if !ok || index >= cur.Len() {
+			return reflect.Value{}, false
+		}
+
+		if last {
+			result := reflect.MakeSlice(cur.Type(), 0, cur.Len()-1)
+			result = reflect.AppendSlice(result, cur.Slice(0, index))
+			result = reflect.AppendSlice(result, cur.Slice(index+1, cur.Len()))
+			return result, true
+		}
+
+		child, ok := deletePathValue(cur.Index(index), segments[1:])
+		if ok {
+			cur.Index(index).Set(child)
+		}
+		return cur, ok
+	case reflect.Array:
+		index, ok := segment.position()
+		if !ok || index >= cur.Len() {
+			return reflect.Value{}, false
+		}
+
+		result := copyPathValue(cur.Type(), cur)
+		return result, deletePathChild(result.Index(index), segments[1:])
+	case reflect.Struct:
+		index, ok := pathFieldIndex(cur.Type(), segment)
+		if !ok {
+			return reflect.Value{}, false
+		}
+
+		result := copyPathValue(cur.Type(), cur)
+		return result, deletePathChild(result.Field(index), segments[1:])
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// deletePathChild 删除可寻址的子节点 child 中的路径，segments 为空时将 child 置为零值。
+func deletePathChild(child reflect.Value, segments []pathSegment) bool {
+	if len(segments) == 0 {
+		child.Set(reflect.Zero(child.Type()))
+		return true
+	}
+
+	value, ok := deletePathValue(child, segments)
+	if ok {
+		child.Set(value)
+	}
+	return ok
+}
+
+// copyPathValue 返回 cur 的一个可寻址的副本，cur 无效时返回 typ 类型的零值。
+func copyPathValue(typ reflect.Type, cur reflect.Value) reflect.Value {
+	result := reflect.New(typ).Elem()
+	if cur.IsValid() {
+		result.Set(cur)
+	}
+	return result
+}
+
+// convertPathValue 将 value 转换为 typ 类型，无法转换时返回 false。
+func convertPathValue(typ reflect.Type, value any) (reflect.Value, bool) {
+	v := reflect.ValueOf(value)
+
+	switch {
+	case !v.IsValid():
+		return reflect.Zero(typ), true
+	case v.Type().AssignableTo(typ):
+		return v, true
+	case typ.Kind() == reflect.String && v.Kind() != reflect.String:
+		// 避免整数被转换为对应码点的字符
+		return reflect.Value{}, false
+	case v.CanConvert(typ):
+		// CanConvert 同时检查切片转换为数组时的长度
+		return v.Convert(typ), true
+	default:
+		return reflect.Value{}, false
+	}
+}
@@ -0,0 +1,291 @@
+package kit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type pathUser struct {
+	Name    string         `json:"name"`
+	Age     int            `json:"age,omitempty"`
+	Tags    []string       `json:"tags"`
+	Extra   map[string]any `json:"extra"`
+	Friend  *pathUser      `json:"friend"`
+	Scores  [2]int         `json:"scores"`
+	Labels  map[int]string
+	Ignored string `json:"-"`
+	Comment string
+	secret  string
+}
+
+func newPathData() map[string]any {
+	var data map[string]any
+	_ = json.Unmarshal([]byte(`{
+		"a": {"b": [{"c": 1}, {"c": "2"}]},
+		"x.y": {"z": true},
+		"list": [1, [2, 3]],
+		"timeout": "1s"
+	}`), &data)
+	return data
+}
+
+func TestGetPath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := newPathData()
+
+	result1, ok1 := GetPath(data, "a.b[0].c")
+	result2, ok2 := GetPath(data, "a.b[1].c")
+	result3, ok3 := GetPath(data, `x\.y.z`)
+	result4, ok4 := GetPath(data, "list[1][0]")
+	result5, ok5 := GetPath(data, "a.b.1.c")
+	result6, ok6 := GetPath(data, "")
+	_, ok7 := GetPath(data, "a.b[2].c")
+	_, ok8 := GetPath(data, "x.y.z")
+	_, ok9 := GetPath(data, "a..b")
+	_, ok10 := GetPath(data, "a.b[0].c.d")
+	result20, ok20 := GetPath(map[string]any{"": map[string]any{"a.b": 1}}, `[""]["a.b"]`)
+	result21, ok21 := GetPath(map[string]any{"x": map[string]any{"]\"": 1}}, `x["]\""]`)
+	_, ok22 := GetPath(data, `a["b]`)
+	_, ok23 := GetPath(data, `a["b"]c`)
+
+	is.True(ok1)
+	is.Equal(float64(1), result1)
+	is.True(ok2)
+	is.Equal("2", result2)
+	is.True(ok3)
+	is.Equal(true, result3)
+	is.True(ok4)
+	is.Equal(float64(2), result4)
+	is.True(ok5)
+	is.Equal("2", result5)
+	is.True(ok6)
+	is.Equal(data, result6)
+	is.False(ok7)
+	is.False(ok8)
+	is.False(ok9)
+	is.False(ok10)
+	is.True(ok20)
+	is.Equal(1, result20)
+	is.True(ok21)
+	is.Equal(1, result21)
+	is.False(ok22)
+	is.False(ok23)
+
+	user := &pathUser{
+		Name:    "foo",
+		Tags:    []string{"a", "b"},
+		Friend:  &pathUser{Name: "bar"},
+		Scores:  [2]int{1, 2},
+		Labels:  map[int]string{1: "one"},
+		Comment: "hi",
+		secret:  "secret",
+	}
+
+	result11, ok11 := GetPath(user, "friend.name")
+	result12, ok12 := GetPath(user, "tags[1]")
+	result13, ok13 := GetPath(user, "Comment")
+	result14, ok14 := GetPath(user, "scores[1]")
+	result15, ok15 := GetPath(map[string]any{"user": user}, "user.Name")
+	result16, ok16 := GetPath(map[int]string{1: "one"}, "1")
+	_, ok17 := GetPath(user, "secret")
+	_, ok18 := GetPath(user, "Ignored")
+	_, ok19 := GetPath(user, "friend.friend.name")
+
+	is.True(ok11)
+	is.Equal("bar", result11)
+	is.True(ok12)
+	is.Equal("b", result12)
+	is.True(ok13)
+	is.Equal("hi", result13)
+	is.True(ok14)
+	is.Equal(2, result14)
+	is.True(ok15)
+	is.Equal("foo", result15)
+	is.True(ok16)
+	is.Equal("one", result16)
+	is.False(ok17)
+	is.False(ok18)
+	is.False(ok19)
+}
+
+func TestGetPathAs(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := newPathData()
+
+	result1, err1 := GetPathAs[int](data, "a.b[0].c")
+	result2, err2 := GetPathAs[int](data, "a.b[1].c")
+	result3, err3 := GetPathAs[string](data, "a.b[0].c")
+	result4, err4 := GetPathAs[time.Duration](data, "timeout")
+	result5, err5 := GetPathAs[[]any](data, "a.b")
+	result6, err6 := GetPathAs[bool](data, `x\.y.z`)
+	_, err7 := GetPathAs[int](data, "a.b[5]")
+	_, err8 := GetPathAs[int](data, `x\.y`)
+	_, err9 := GetPathAs[[]string](data, "a.b")
+
+	is.Nil(err1)
+	is.Equal(1, result1)
+	is.Nil(err2)
+	is.Equal(2, result2)
+	is.Nil(err3)
+	is.Equal("1", result3)
+	is.Nil(err4)
+	is.Equal(time.Second, result4)
+	is.Nil(err5)
+	is.Len(result5, 2)
+	is.Nil(err6)
+	is.True(result6)
+	is.NotNil(err7)
+	is.NotNil(err8)
+	is.NotNil(err9)
+
+	type status string
+	type level int
+
+	nested := map[string]any{"a": nil, "status": "active", "level": 3, "ptr": (*int)(nil)}
+
+	result10, err10 := GetPathAs[string](nested, "a")
+	result11, err11 := GetPathAs[int](nested, "a")
+	result12, err12 := GetPathAs[[]string](nested, "a")
+	result13, err13 := GetPathAs[status](nested, "status")
+	result14, err14 := GetPathAs[level](nested, "level")
+	_, err15 := GetPathAs[status](nested, "level")
+
+	is.Nil(err10)
+	is.Equal("", result10)
+	is.Nil(err11)
+	is.Equal(0, result11)
+	is.Nil(err12)
+	is.Nil(result12)
+	is.Nil(err13)
+	is.Equal(status("active"), result13)
+	is.Nil(err14)
+	is.Equal(level(3), result14)
+	is.NotNil(err15)
+
+	arrays := map[string]any{"short": []int{1}, "full": []int{1, 2, 3}}
+
+	_, err16 := GetPathAs[[3]int](arrays, "short")
+	_, err17 := GetPathAs[*[3]int](arrays, "short")
+	result18, err18 := GetPathAs[[3]int](arrays, "full")
+
+	is.EqualError(err16, `getPath: unable to convert []int to [3]int at "short"`)
+	is.NotNil(err17)
+	is.Nil(err18)
+	is.Equal([3]int{1, 2, 3}, result18)
+}
+
+func TestHasPath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := newPathData()
+
+	is.True(HasPath(data, "a.b[1]"))
+	is.True(HasPath(data, `x\.y`))
+	is.False(HasPath(data, "a.c"))
+	is.False(HasPath(data, "a.b[0"))
+	is.False(HasPath(nil, "a"))
+}
+
+func TestSetPath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := newPathData()
+
+	is.Nil(SetPath(data, "a.b[0].c", 10))
+	is.Nil(SetPath(data, "a.b[2].c", 30))
+	is.Nil(SetPath(data, "new.list[0].name", "foo"))
+	is.Nil(SetPath(data, `x\.y.w`, false))
+	is.Nil(SetPath(data, "list[1][1]", 4))
+
+	is.Equal(10, data["a"].(map[string]any)["b"].([]any)[0].(map[string]any)["c"])
+	is.Equal(map[string]any{"c": 30}, data["a"].(map[string]any)["b"].([]any)[2])
+	is.Equal(map[string]any{"list": []any{map[string]any{"name": "foo"}}}, data["new"])
+	is.Equal(map[string]any{"z": true, "w": false}, data["x.y"])
+	is.Equal([]any{float64(2), 4}, data["list"].([]any)[1])
+
+	is.NotNil(SetPath(data, "a.b[5]", 1))
+	is.NotNil(SetPath(data, "timeout.value", 1))
+	is.NotNil(SetPath(data, "", 1))
+	is.NotNil(SetPath(map[string]any{}, "a[x]", 1))
+	is.NotNil(SetPath(map[string]any(nil), "a", 1))
+	is.NotNil(SetPath(pathUser{}, "name", "foo"))
+
+	user := &pathUser{}
+
+	is.Nil(SetPath(user, "name", "foo"))
+	is.Nil(SetPath(user, "age", int32(18)))
+	is.Nil(SetPath(user, "tags[0]", "a"))
+	is.Nil(SetPath(user, "friend.extra.key", "value"))
+	is.Nil(SetPath(user, "scores[1]", 2))
+	is.Nil(SetPath(user, "Labels.1", "one"))
+
+	is.Equal(&pathUser{
+		Name:   "foo",
+		Age:    18,
+		Tags:   []string{"a"},
+		Friend: &pathUser{Extra: map[string]any{"key": "value"}},
+		Scores: [2]int{0, 2},
+		Labels: map[int]string{1: "one"},
+	}, user)
+
+	is.NotNil(SetPath(user, "name", 1))
+	is.NotNil(SetPath(user, "scores[2]", 1))
+	is.NotNil(SetPath(user, "unknown", 1))
+	is.NotNil(SetPath(user, "Labels.one", "one"))
+
+	vector := &struct{ A [3]int }{}
+	is.EqualError(SetPath(vector, "A", []int{1}), "setPath: cannot use []int as [3]int")
+	is.Nil(SetPath(vector, "A", []int{1, 2, 3}))
+	is.Equal([3]int{1, 2, 3}, vector.A)
+	is.Equal("foo", user.Name)
+}
+
+func TestDeletePath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data := newPathData()
+
+	is.True(DeletePath(data, "a.b[0]"))
+	is.True(DeletePath(data, `x\.y.z`))
+	is.True(DeletePath(data, "list[1][0]"))
+	is.True(DeletePath(data, "timeout"))
+	is.False(DeletePath(data, "timeout"))
+	is.False(DeletePath(data, "a.b[3]"))
+	is.False(DeletePath(data, ""))
+
+	is.Equal(map[string]any{
+		"a":    map[string]any{"b": []any{map[string]any{"c": "2"}}},
+		"x.y":  map[string]any{},
+		"list": []any{float64(1), []any{float64(3)}},
+	}, data)
+
+	user := &pathUser{
+		Name:   "foo",
+		Tags:   []string{"a", "b", "c"},
+		Friend: &pathUser{Name: "bar"},
+		Scores: [2]int{1, 2},
+	}
+
+	is.True(DeletePath(user, "tags[1]"))
+	is.True(DeletePath(user, "friend.name"))
+	is.True(DeletePath(user, "scores[0]"))
+	is.True(DeletePath(user, "name"))
+	is.False(DeletePath(user, "unknown"))
+	is.False(DeletePath(*user, "tags"))
+
+	is.Equal(&pathUser{
+		Tags:   []string{"a", "c"},
+		Friend: &pathUser{},
+		Scores: [2]int{0, 2},
+	}, user)
+}
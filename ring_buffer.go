@@ -0,0 +1,115 @@
+package kit
+
+// RingBufferPolicy 环形缓冲区已满时的写入策略。
+type RingBufferPolicy int
+
+const (
+	// RingBufferOverwrite 缓冲区已满时覆盖最旧的元素。
+	RingBufferOverwrite RingBufferPolicy = iota
+	// RingBufferReject 缓冲区已满时拒绝写入新的元素。
+	RingBufferReject
+)
+
+// RingBuffer 固定容量的环形缓冲区。
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int
+	count  int
+	policy RingBufferPolicy
+}
+
+// NewRingBuffer 创建容量为 capacity 的环形缓冲区。容量小于 1 时会被修正为 1。
+func NewRingBuffer[T any](capacity int, policy RingBufferPolicy) *RingBuffer[T] {
+	return &RingBuffer[T]{
+		buf:    make([]T, Max(capacity, 1)),
+		policy: policy,
+	}
+}
+
+// Len 返回缓冲区中元素的个数。
+func (r *RingBuffer[T]) Len() int {
+	return r.count
+}
+
+// Cap 返回缓冲区的容量。
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// IsFull 判断缓冲区是否已满。
+func (r *RingBuffer[T]) IsFull() bool {
+	return r.count == len(r.buf)
+}
+
+// Push 写入元素，返回是否写入成功。
+// 缓冲区已满时，RingBufferOverwrite 策略会覆盖最旧的元素，RingBufferReject 策略会拒绝写入并返回 false。
+func (r *RingBuffer[T]) Push(item T) bool {
+	if r.IsFull() {
+		if r.policy == RingBufferReject {
+			return false
+		}
+
+		r.buf[r.head] = item
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+
+	r.buf[r.index(r.count)] = item
+	r.count++
+
+	return true
+}
+
+// Pop 删除并返回最旧的元素，缓冲区为空时返回零值和 false。
+func (r *RingBuffer[T]) Pop() (T, bool) {
+	if r.count == 0 {
+		return Empty[T](), false
+	}
+
+	item := r.buf[r.head]
+	r.buf[r.head] = Empty[T]()
+	r.head = (r.head + 1) % len(r.buf)
+	r.count--
+
+	return item, true
+}
+
+// Peek 返回最旧的元素但不删除，缓冲区为空时返回零值和 false。
+func (r *RingBuffer[T]) Peek() (T, bool) {
+	return r.At(0)
+}
+
+// PeekNewest 返回最新的元素但不删除，缓冲区为空时返回零值和 false。
+func (r *RingBuffer[T]) PeekNewest() (T, bool) {
+	return r.At(r.count - 1)
+}
+
+// At 返回下标 i 处的元素，下标 0 为最旧的元素。下标越界时返回零值和 false。
+func (r *RingBuffer[T]) At(i int) (T, bool) {
+	if i < 0 || i >= r.count {
+		return Empty[T](), false
+	}
+	return r.buf[r.index(i)], true
+}
+
+// Clear 清空缓冲区。
+func (r *RingBuffer[T]) Clear() {
+	r.buf = make([]T, len(r.buf))
+	r.head = 0
+	r.count = 0
+}
+
+// ToSlice 按从旧到新的顺序返回所有元素。
+func (r *RingBuffer[T]) ToSlice() []T {
+	result := make([]T, 0, r.count)
+
+	for i := 0; i < r.count; i++ {
+		result = append(result, r.buf[r.index(i)])
+	}
+
+	return result
+}
+
+func (r *RingBuffer[T]) index(i int) int {
+	return (r.head + i) % len(r.buf)
+}
@@ -0,0 +1,74 @@
+package kit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRingBuffer[int](3, RingBufferOverwrite)
+	is.Equal(3, r.Cap())
+
+	for i := 1; i <= 5; i++ {
+		is.True(r.Push(i))
+	}
+
+	is.True(r.IsFull())
+	is.Equal(3, r.Len())
+	is.Equal([]int{3, 4, 5}, r.ToSlice())
+
+	oldest, ok := r.Peek()
+	is.True(ok)
+	is.Equal(3, oldest)
+
+	newest, ok := r.PeekNewest()
+	is.True(ok)
+	is.Equal(5, newest)
+
+	item, ok := r.At(1)
+	is.True(ok)
+	is.Equal(4, item)
+	_, ok = r.At(3)
+	is.False(ok)
+
+	item, ok = r.Pop()
+	is.True(ok)
+	is.Equal(3, item)
+
+	r.Push(6)
+	is.Equal([]int{4, 5, 6}, r.ToSlice())
+	is.Equal([]int{5, 6}, Filter(r.ToSlice(), func(_ int, x int) bool {
+		return x > 4
+	}))
+
+	r.Clear()
+	is.Equal(0, r.Len())
+	_, ok = r.Pop()
+	is.False(ok)
+	_, ok = r.Peek()
+	is.False(ok)
+	_, ok = r.PeekNewest()
+	is.False(ok)
+}
+
+func TestRingBufferReject(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRingBuffer[string](2, RingBufferReject)
+
+	is.True(r.Push("a"))
+	is.True(r.Push("b"))
+	is.False(r.Push("c"))
+	is.Equal([]string{"a", "b"}, r.ToSlice())
+
+	r.Pop()
+	is.True(r.Push("c"))
+	is.Equal([]string{"b", "c"}, r.ToSlice())
+
+	is.Equal(1, NewRingBuffer[int](0, RingBufferReject).Cap())
+}
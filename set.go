@@ -0,0 +1,314 @@
+package kit
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Set 无序且元素唯一的集合。零值可以直接使用。
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet 使用给定的元素创建集合。
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	s.Add(items...)
+	return s
+}
+
+// Add 向集合中添加元素。
+func (s *Set[T]) Add(items ...T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{}, len(items))
+	}
+
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+}
+
+// Remove 从集合中删除元素。
+func (s *Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s.items, item)
+	}
+}
+
+// Has 判断元素是否在集合中。
+func (s *Set[T]) Has(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len 返回集合中元素的个数。
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Clear 清空集合。
+func (s *Set[T]) Clear() {
+	s.items = make(map[T]struct{})
+}
+
+// Clone 返回集合的浅拷贝。
+func (s *Set[T]) Clone() *Set[T] {
+	res := &Set[T]{items: make(map[T]struct{}, len(s.items))}
+
+	for item := range s.items {
+		res.items[item] = struct{}{}
+	}
+
+	return res
+}
+
+// Range 遍历集合中的所有元素，当 iteratee 返回 false 时停止遍历。遍历顺序是随机的。
+func (s *Set[T]) Range(iteratee func(item T) bool) {
+	for item := range s.items {
+		if !iteratee(item) {
+			return
+		}
+	}
+}
+
+// Union 返回当前集合与其他集合的并集。
+func (s *Set[T]) Union(others ...*Set[T]) *Set[T] {
+	res := s.Clone()
+
+	for _, other := range others {
+		for item := range other.items {
+			res.items[item] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// Intersect 返回当前集合与其他集合的交集。
+func (s *Set[T]) Intersect(others ...*Set[T]) *Set[T] {
+	res := &Set[T]{items: make(map[T]struct{})}
+
+	for item := range s.items {
+		if EveryBy(others, func(other *Set[T]) bool { return other.Has(item) }) {
+			res.items[item] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// Difference 返回当前集合中存在但 other 中不存在的元素组成的集合。
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	res := &Set[T]{items: make(map[T]struct{})}
+
+	for item := range s.items {
+		if !other.Has(item) {
+			res.items[item] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// SymmetricDifference 返回只存在于其中一个集合中的元素组成的集合。
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	res := s.Difference(other)
+
+	for item := range other.items {
+		if !s.Has(item) {
+			res.items[item] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// IsSubset 判断当前集合是否是 other 的子集。
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for item := range s.items {
+		if !other.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset 判断当前集合是否是 other 的超集。
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal 判断两个集合是否包含相同的元素。
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// ToSlice 将集合转换为切片，元素顺序是随机的。
+func (s *Set[T]) ToSlice() []T {
+	return Keys(s.items)
+}
+
+// ToSortedSlice 将集合转换为切片，并使用比较函数排序。
+func (s *Set[T]) ToSortedSlice(comparator Comparator[T]) []T {
+	return SortStableFunc(s.ToSlice(), comparator)
+}
+
+// MarshalJSON 将集合序列化为 JSON 数组。
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON 从 JSON 数组反序列化集合，重复的元素会被合并。
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	s.items = make(map[T]struct{}, len(items))
+	s.Add(items...)
+
+	return nil
+}
+
+// SyncSet 并发安全的集合。零值可以直接使用。
+type SyncSet[T comparable] struct {
+	mu  sync.RWMutex
+	set Set[T]
+}
+
+// NewSyncSet 使用给定的元素创建并发安全的集合。
+func NewSyncSet[T comparable](items ...T) *SyncSet[T] {
+	return &SyncSet[T]{set: *NewSet(items...)}
+}
+
+// Add 向集合中添加元素。
+func (s *SyncSet[T]) Add(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Add(items...)
+}
+
+// Remove 从集合中删除元素。
+func (s *SyncSet[T]) Remove(items ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Remove(items...)
+}
+
+// Has 判断元素是否在集合中。
+func (s *SyncSet[T]) Has(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Has(item)
+}
+
+// Len 返回集合中元素的个数。
+func (s *SyncSet[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Len()
+}
+
+// Clear 清空集合。
+func (s *SyncSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set.Clear()
+}
+
+// Range 遍历集合中的所有元素，当 iteratee 返回 false 时停止遍历。
+// 遍历期间持有读锁，不能在 iteratee 中修改集合。
+func (s *SyncSet[T]) Range(iteratee func(item T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.set.Range(iteratee)
+}
+
+// Snapshot 返回集合当前状态的拷贝，可以使用 Set 的方法进行集合运算。
+func (s *SyncSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.Clone()
+}
+
+// Union 返回当前集合与其他集合的并集。
+func (s *SyncSet[T]) Union(others ...*SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: *s.Snapshot().Union(snapshots(others)...)}
+}
+
+// Intersect 返回当前集合与其他集合的交集。
+func (s *SyncSet[T]) Intersect(others ...*SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: *s.Snapshot().Intersect(snapshots(others)...)}
+}
+
+// Difference 返回当前集合中存在但 other 中不存在的元素组成的集合。
+func (s *SyncSet[T]) Difference(other *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: *s.Snapshot().Difference(other.Snapshot())}
+}
+
+// SymmetricDifference 返回只存在于其中一个集合中的元素组成的集合。
+func (s *SyncSet[T]) SymmetricDifference(other *SyncSet[T]) *SyncSet[T] {
+	return &SyncSet[T]{set: *s.Snapshot().SymmetricDifference(other.Snapshot())}
+}
+
+// IsSubset 判断当前集合是否是 other 的子集。
+func (s *SyncSet[T]) IsSubset(other *SyncSet[T]) bool {
+	return s.Snapshot().IsSubset(other.Snapshot())
+}
+
+// IsSuperset 判断当前集合是否是 other 的超集。
+func (s *SyncSet[T]) IsSuperset(other *SyncSet[T]) bool {
+	return s.Snapshot().IsSuperset(other.Snapshot())
+}
+
+// ToSlice 将集合转换为切片，元素顺序是随机的。
+func (s *SyncSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.set.ToSlice()
+}
+
+// ToSortedSlice 将集合转换为切片，并使用比较函数排序。
+func (s *SyncSet[T]) ToSortedSlice(comparator Comparator[T]) []T {
+	return SortStableFunc(s.ToSlice(), comparator)
+}
+
+// MarshalJSON 将集合序列化为 JSON 数组。
+func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON 从 JSON 数组反序列化集合，重复的元素会被合并。
+func (s *SyncSet[T]) UnmarshalJSON(data []byte) error {
+	var set Set[T]
+	if err := set.UnmarshalJSON(data); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set = set
+
+	return nil
+}
+
+func snapshots[T comparable](sets []*SyncSet[T]) []*Set[T] {
+	return Map(sets, func(_ int, set *SyncSet[T]) *Set[T] {
+		return set.Snapshot()
+	})
+}
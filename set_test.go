@@ -0,0 +1,151 @@
+package kit
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	s := NewSet(1, 2, 2, 3)
+	is.Equal(3, s.Len())
+	is.True(s.Has(2))
+	is.False(s.Has(4))
+
+	s.Add(4, 5)
+	s.Remove(1, 6)
+	is.Equal([]int{2, 3, 4, 5}, s.ToSortedSlice(Asc[int]))
+	is.Equal([]int{5, 4, 3, 2}, s.ToSortedSlice(Desc[int]))
+
+	clone := s.Clone()
+	clone.Add(6)
+	is.False(s.Has(6))
+
+	s.Clear()
+	is.Equal(0, s.Len())
+
+	var zero Set[string]
+	is.False(zero.Has("foo"))
+	zero.Add("foo")
+	is.True(zero.Has("foo"))
+}
+
+func TestSetRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	s := NewSet(1, 2, 3)
+
+	sum := 0
+	s.Range(func(item int) bool {
+		sum += item
+		return true
+	})
+	is.Equal(6, sum)
+
+	count := 0
+	s.Range(func(item int) bool {
+		count++
+		return false
+	})
+	is.Equal(1, count)
+}
+
+func TestSetAlgebra(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+	c := NewSet(4, 5, 6)
+
+	is.Equal([]int{1, 2, 3, 4, 5}, a.Union(b).ToSortedSlice(Asc[int]))
+	is.Equal([]int{1, 2, 3, 4, 5, 6}, a.Union(b, c).ToSortedSlice(Asc[int]))
+	is.Equal([]int{3, 4}, a.Intersect(b).ToSortedSlice(Asc[int]))
+	is.Equal([]int{4}, a.Intersect(b, c).ToSortedSlice(Asc[int]))
+	is.Equal([]int{1, 2}, a.Difference(b).ToSortedSlice(Asc[int]))
+	is.Equal([]int{1, 2, 5}, a.SymmetricDifference(b).ToSortedSlice(Asc[int]))
+
+	is.True(NewSet(3, 4).IsSubset(a))
+	is.False(b.IsSubset(a))
+	is.True(a.IsSuperset(NewSet[int]()))
+	is.False(b.IsSuperset(a))
+	is.True(a.Equal(NewSet(4, 3, 2, 1)))
+	is.False(a.Equal(b))
+
+	// 集合运算不会修改原集合
+	is.Equal([]int{1, 2, 3, 4}, a.ToSortedSlice(Asc[int]))
+}
+
+func TestSetJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data, err := json.Marshal(NewSet("foo"))
+	is.Nil(err)
+	is.Equal(`["foo"]`, string(data))
+
+	var s Set[string]
+	is.Nil(json.Unmarshal([]byte(`["foo","bar","foo"]`), &s))
+	is.Equal([]string{"bar", "foo"}, s.ToSortedSlice(Asc[string]))
+
+	is.NotNil(json.Unmarshal([]byte(`{"foo":1}`), &s))
+
+	type wrapper struct {
+		Tags *Set[int] `json:"tags"`
+	}
+
+	var w wrapper
+	is.Nil(json.Unmarshal([]byte(`{"tags":[3,1,2]}`), &w))
+	is.Equal([]int{1, 2, 3}, w.Tags.ToSortedSlice(Asc[int]))
+}
+
+func TestSyncSet(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	s := NewSyncSet[int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				s.Add(i*100 + j)
+				s.Has(j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	is.Equal(1000, s.Len())
+
+	a := NewSyncSet(1, 2, 3)
+	b := NewSyncSet(2, 3, 4)
+
+	is.Equal([]int{1, 2, 3, 4}, a.Union(b).ToSortedSlice(Asc[int]))
+	is.Equal([]int{2, 3}, a.Intersect(b).ToSortedSlice(Asc[int]))
+	is.Equal([]int{1}, a.Difference(b).ToSortedSlice(Asc[int]))
+	is.Equal([]int{1, 4}, a.SymmetricDifference(b).ToSortedSlice(Asc[int]))
+	is.True(NewSyncSet(2).IsSubset(a))
+	is.True(a.IsSuperset(NewSyncSet(1, 3)))
+
+	a.Remove(1)
+	is.False(a.Has(1))
+	is.Equal([]int{2, 3}, a.Snapshot().ToSortedSlice(Asc[int]))
+
+	data, err := json.Marshal(NewSyncSet(1))
+	is.Nil(err)
+	is.Equal(`[1]`, string(data))
+
+	var u SyncSet[int]
+	is.Nil(json.Unmarshal([]byte(`[1,1,2]`), &u))
+	is.Equal(2, u.Len())
+}
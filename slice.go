@@ -3,6 +3,7 @@ package kit
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"strings"
 
 	"golang.org/x/exp/constraints"
@@ -16,6 +17,11 @@ func AppendIfAbsent[T comparable](slice []T, item T) []T {
 	return slice
 }
 
+// Associate 根据 transform 将数组转换为键值对，等价于 SliceToMap。
+func Associate[T any, K comparable, V any](slice []T, transform func(T) (K, V)) map[K]V {
+	return SliceToMap(slice, transform)
+}
+
 // Contain 判断元素是否在集合中。
 func Contain[T comparable](slice []T, target T) bool {
 	for _, item := range slice {
@@ -88,6 +94,17 @@ func CountBy[T any](slice []T, predicate func(int, T) bool) int {
 	return count
 }
 
+// CountByKey 根据 iteratee 函数计算每个元素的键，统计每个键对应的元素个数。
+func CountByKey[T any, K comparable](slice []T, iteratee func(T) K) map[K]int {
+	result := make(map[K]int)
+
+	for _, item := range slice {
+		result[iteratee(item)]++
+	}
+
+	return result
+}
+
 // CountValues 统计集合中元素的出现次数。
 func CountValues[T comparable](slice []T) map[T]int {
 	result := make(map[T]int)
@@ -254,12 +271,13 @@ func EveryBy[T any](slice []T, predicate func(item T) bool) bool {
 	return true
 }
 
-// Fill	使用 initial 填充集合。
-func Fill[T Clonable[T]](slice []T, initial T) []T {
+// Fill	使用 initial 填充集合，每个元素都是 initial 的深拷贝。
+// 元素实现了 Clonable 时使用 Clone 复制，否则使用 DeepClone 复制。
+func Fill[T any](slice []T, initial T) []T {
 	result := make([]T, 0, len(slice))
 
 	for range slice {
-		result = append(result, initial.Clone())
+		result = append(result, DeepClone(initial))
 	}
 
 	return result
@@ -415,6 +433,59 @@ func FindUniquesBy[T any, U comparable](collecton []T, iteratee func(T) U) []T {
 	return result
 }
 
+// FlatMap 使用 iteratee 函数将集合中的每个元素转换为切片，并将所有结果拼接为一个切片。
+func FlatMap[T any, U any](slice []T, iteratee func(int, T) []U) []U {
+	result := make([]U, 0, len(slice))
+
+	for i, item := range slice {
+		result = append(result, iteratee(i, item)...)
+	}
+
+	return result
+}
+
+// Flatten 将二维切片展开为一维切片。
+func Flatten[T any](slices [][]T) []T {
+	size := 0
+	for _, slice := range slices {
+		size += len(slice)
+	}
+
+	result := make([]T, 0, size)
+
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+
+// FlattenDeep 递归展开任意层级嵌套的切片或数组。
+// 非切片、数组类型的元素会原样保留，nil 切片不产生任何元素。
+func FlattenDeep(slice []any) []any {
+	result := make([]any, 0, len(slice))
+
+	for _, item := range slice {
+		result = flattenDeep(result, item)
+	}
+
+	return result
+}
+
+func flattenDeep(result []any, item any) []any {
+	value := reflect.ValueOf(item)
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			result = flattenDeep(result, value.Index(i).Interface())
+		}
+		return result
+	default:
+		return append(result, item)
+	}
+}
+
 // ForEach 遍历集合中的所有元素并执行 iteratee 函数。
 func ForEach[T any](slice []T, iteratee func(int, T)) {
 	for i, item := range slice {
@@ -434,6 +505,40 @@ func GroupBy[T any, U comparable](slice []T, iteratee func(T) U) map[U][]T {
 	return result
 }
 
+// GroupByMap 根据 key 函数对集合进行分组，并使用 value 函数转换分组中的元素。
+func GroupByMap[T any, K comparable, V any](slice []T, key func(T) K, value func(T) V) map[K][]V {
+	result := make(map[K][]V)
+
+	for _, item := range slice {
+		k := key(item)
+		result[k] = append(result[k], value(item))
+	}
+
+	return result
+}
+
+// GroupByOrdered 与 GroupBy 相同，但以键值对数组的形式返回分组结果。
+// 分组的顺序由每个键在集合中第一次出现的顺序决定。
+func GroupByOrdered[T any, U comparable](slice []T, iteratee func(T) U) []Entry[U, []T] {
+	index := make(map[U]int)
+	result := []Entry[U, []T]{}
+
+	for _, item := range slice {
+		key := iteratee(item)
+
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			result = append(result, Entry[U, []T]{Key: key})
+		}
+
+		result[i].Value = append(result[i].Value, item)
+	}
+
+	return result
+}
+
 // IndexOf 返回在数组中找到值的第一次出现的索引。如果找不到该值则返回-1。
 func IndexOf[T comparable](slice []T, element T) int {
 	for i, item := range slice {
@@ -523,6 +628,24 @@ func IsSorted[T constraints.Ordered](slice []T) bool {
 	return IsAscending(slice) || IsDescending(slice)
 }
 
+// IsSortedBy 根据 iteratee 函数返回的键判断数组是否有序，从小到大或从大到小。
+func IsSortedBy[T any, K constraints.Ordered](slice []T, iteratee func(T) K) bool {
+	keys := Map(slice, func(_ int, item T) K {
+		return iteratee(item)
+	})
+	return IsSorted(keys)
+}
+
+// IsSortedFunc 判断数组是否按照比较函数从小到大有序。
+func IsSortedFunc[T any](slice []T, comparator Comparator[T]) bool {
+	for i := 1; i < len(slice); i++ {
+		if comparator(slice[i-1], slice[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // Join 使用 sepratator 连接数组中所有元素返回一个字符串。
 func Join[T any](slice []T, separator string) string {
 	str := Map(slice, func(i int, item T) string {
@@ -531,6 +654,33 @@ func Join[T any](slice []T, separator string) string {
 	return strings.Join(str, separator)
 }
 
+// KeyBy 根据 iteratee 函数计算每个元素的键，将集合转换为键到元素的映射。
+// 如果多个元素的键相同，后遍历的元素会覆盖先前的元素。
+func KeyBy[T any, K comparable](slice []T, iteratee func(T) K) map[K]T {
+	result := make(map[K]T, len(slice))
+
+	for _, item := range slice {
+		result[iteratee(item)] = item
+	}
+
+	return result
+}
+
+// KeyByFirst 与 KeyBy 相同，但多个元素的键相同时保留第一个元素。
+func KeyByFirst[T any, K comparable](slice []T, iteratee func(T) K) map[K]T {
+	result := make(map[K]T, len(slice))
+
+	for _, item := range slice {
+		key := iteratee(item)
+
+		if _, ok := result[key]; !ok {
+			result[key] = item
+		}
+	}
+
+	return result
+}
+
 // Last 返回集合中最后一个元素，如果为空则返回错误。
 func Last[T any](slice []T) (T, error) {
 	length := len(slice)
@@ -622,12 +772,46 @@ func NoneBy[T any](slice []T, predicate func(item T) bool) bool {
 	return true
 }
 
-// Repeat 创建一个长度为 count 所有元素为 initial 的切片。
-func Repeat[T Clonable[T]](count int, initial T) []T {
+// Reduce 从左到右遍历集合，将 accumulator 函数的返回值作为下一次调用的累加值，返回最终的累加结果。
+func Reduce[T any, R any](slice []T, accumulator func(acc R, item T, i int) R, initial R) R {
+	for i, item := range slice {
+		initial = accumulator(initial, item, i)
+	}
+
+	return initial
+}
+
+// ReduceRight 与 Reduce 相同，但从右到左遍历集合。
+func ReduceRight[T any, R any](slice []T, accumulator func(acc R, item T, i int) R, initial R) R {
+	for i := len(slice) - 1; i >= 0; i-- {
+		initial = accumulator(initial, slice[i], i)
+	}
+
+	return initial
+}
+
+// ReduceWhile 与 Reduce 相同，但当 accumulator 函数的第二个返回值为 false 时立即停止遍历，
+// 并返回此次调用得到的累加值。
+func ReduceWhile[T any, R any](slice []T, accumulator func(acc R, item T, i int) (R, bool), initial R) R {
+	for i, item := range slice {
+		acc, ok := accumulator(initial, item, i)
+		initial = acc
+
+		if !ok {
+			break
+		}
+	}
+
+	return initial
+}
+
+// Repeat 创建一个长度为 count 所有元素为 initial 的切片，每个元素都是 initial 的深拷贝。
+// 元素实现了 Clonable 时使用 Clone 复制，否则使用 DeepClone 复制。
+func Repeat[T any](count int, initial T) []T {
 	result := make([]T, 0, count)
 
 	for i := 0; i < count; i++ {
-		result = append(result, initial.Clone())
+		result = append(result, DeepClone(initial))
 	}
 
 	return result
@@ -709,6 +893,18 @@ func Samples[T any](slice []T, count int) []T {
 	return results
 }
 
+// Scan 与 Reduce 相同，但返回每一步的累加结果组成的切片，不包含初始值。
+func Scan[T any, R any](slice []T, accumulator func(acc R, item T, i int) R, initial R) []R {
+	result := make([]R, 0, len(slice))
+
+	for i, item := range slice {
+		initial = accumulator(initial, item, i)
+		result = append(result, initial)
+	}
+
+	return result
+}
+
 // Slice 对集合截取切片，能够处理数组越界的问题而不 panic。
 func Slice[T any](slice []T, start int, end int) []T {
 	size := len(slice)
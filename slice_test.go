@@ -381,6 +381,11 @@ func TestFill(t *testing.T) {
 
 	is.Equal(result1, []foo{{"b"}, {"b"}})
 	is.Equal(result2, []foo{})
+
+	result3 := Fill(make([]map[string][]int, 2), map[string][]int{"a": {1}})
+	result3[0]["a"][0] = 2
+
+	is.Equal([]map[string][]int{{"a": {2}}, {"a": {1}}}, result3)
 }
 
 func TestRepeat(t *testing.T) {
@@ -392,6 +397,11 @@ func TestRepeat(t *testing.T) {
 
 	is.Equal(result1, []foo{{"a"}, {"a"}})
 	is.Equal(result2, []foo{})
+
+	result3 := Repeat(2, []int{1, 2})
+	result3[0][0] = 3
+
+	is.Equal([][]int{{3, 2}, {1, 2}}, result3)
 }
 
 func TestRepeatBy(t *testing.T) {
@@ -1107,3 +1117,221 @@ func TestDropRightWhile(t *testing.T) {
 	})
 	assert.Equal([]int{1, 2, 3, 4, 5}, r3)
 }
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := Flatten([][]int{{0, 1}, {2, 3, 4, 5}})
+	result2 := Flatten([][]int{{}, nil, {1}})
+	result3 := Flatten([][]int{})
+
+	is.Equal(result1, []int{0, 1, 2, 3, 4, 5})
+	is.Equal(result2, []int{1})
+	is.Equal(result3, []int{})
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := FlatMap([]int{1, 2, 3}, func(i int, x int) []string {
+		return RepeatBy(x, func(_ int) string {
+			return fmt.Sprint(i)
+		})
+	})
+	result2 := FlatMap([]int{}, func(_ int, x int) []int {
+		return []int{x}
+	})
+
+	is.Equal(result1, []string{"0", "1", "1", "2", "2", "2"})
+	is.Equal(result2, []int{})
+}
+
+func TestFlattenDeep(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := FlattenDeep([]any{1, []any{2, []int{3, 4}, [2]string{"a", "b"}}, []any{[]any{[]any{5}}}})
+	result2 := FlattenDeep([]any{nil, []int(nil), "foo"})
+	result3 := FlattenDeep([]any{})
+
+	is.Equal(result1, []any{1, 2, 3, 4, "a", "b", 5})
+	is.Equal(result2, []any{nil, "foo"})
+	is.Equal(result3, []any{})
+}
+
+func TestReduce(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := Reduce([]int{1, 2, 3, 4}, func(acc int, item int, _ int) int {
+		return acc + item
+	}, 0)
+	result2 := Reduce([]int{1, 2, 3, 4}, func(acc []string, item int, i int) []string {
+		return append(acc, fmt.Sprintf("%d:%d", i, item))
+	}, []string{})
+	result3 := Reduce([]int{}, func(acc int, item int, _ int) int {
+		return acc + item
+	}, 10)
+
+	is.Equal(result1, 10)
+	is.Equal(result2, []string{"0:1", "1:2", "2:3", "3:4"})
+	is.Equal(result3, 10)
+}
+
+func TestReduceRight(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := ReduceRight([][]int{{0, 1}, {2, 3}, {4, 5}}, func(acc []int, item []int, _ int) []int {
+		return append(acc, item...)
+	}, []int{})
+	result2 := ReduceRight([]string{"a", "b", "c"}, func(acc string, item string, i int) string {
+		return acc + item + fmt.Sprint(i)
+	}, "")
+
+	is.Equal(result1, []int{4, 5, 2, 3, 0, 1})
+	is.Equal(result2, "c2b1a0")
+}
+
+func TestReduceWhile(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	visited := []int{}
+	result1 := ReduceWhile([]int{1, 2, 3, 4, 5}, func(acc int, item int, i int) (int, bool) {
+		visited = append(visited, i)
+		return acc + item, acc+item < 6
+	}, 0)
+	result2 := ReduceWhile([]int{1, 2, 3}, func(acc int, item int, _ int) (int, bool) {
+		return acc * item, true
+	}, 1)
+
+	is.Equal(result1, 6)
+	is.Equal(visited, []int{0, 1, 2})
+	is.Equal(result2, 6)
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := Scan([]int{1, 2, 3, 4}, func(acc int, item int, _ int) int {
+		return acc + item
+	}, 0)
+	result2 := Scan([]int{}, func(acc int, item int, _ int) int {
+		return acc + item
+	}, 0)
+
+	is.Equal(result1, []int{1, 3, 6, 10})
+	is.Equal(result2, []int{})
+}
+
+func TestAssociate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result := Associate([]string{"a", "bb", "ccc"}, func(s string) (string, int) {
+		return s, len(s)
+	})
+
+	is.Equal(result, map[string]int{"a": 1, "bb": 2, "ccc": 3})
+}
+
+func TestKeyBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type user struct {
+		name string
+		age  int
+	}
+
+	users := []user{{"foo", 18}, {"bar", 20}, {"baz", 18}}
+	age := func(u user) int {
+		return u.age
+	}
+
+	is.Equal(KeyBy(users, age), map[int]user{18: {"baz", 18}, 20: {"bar", 20}})
+	is.Equal(KeyByFirst(users, age), map[int]user{18: {"foo", 18}, 20: {"bar", 20}})
+	is.Equal(KeyBy([]user{}, age), map[int]user{})
+}
+
+func TestGroupByMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := GroupByMap([]int{0, 1, 2, 3, 4, 5}, func(i int) int {
+		return i % 3
+	}, func(i int) string {
+		return fmt.Sprint(i)
+	})
+
+	is.Equal(result1, map[int][]string{
+		0: {"0", "3"},
+		1: {"1", "4"},
+		2: {"2", "5"},
+	})
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := GroupByOrdered([]int{5, 1, 2, 3, 4, 0}, func(i int) int {
+		return i % 3
+	})
+	result2 := GroupByOrdered([]int{}, func(i int) int {
+		return i % 3
+	})
+
+	is.Equal(result1, []Entry[int, []int]{
+		{Key: 2, Value: []int{5, 2}},
+		{Key: 1, Value: []int{1, 4}},
+		{Key: 0, Value: []int{3, 0}},
+	})
+	is.Equal(result2, []Entry[int, []int]{})
+}
+
+func TestCountByKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := CountByKey([]string{"foo", "bar", "hello", "world"}, func(s string) int {
+		return len(s)
+	})
+	result2 := CountByKey([]string{}, func(s string) int {
+		return len(s)
+	})
+
+	is.Equal(result1, map[int]int{3: 2, 5: 2})
+	is.Equal(result2, map[int]int{})
+}
+
+func TestIsSortedBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	length := func(s string) int {
+		return len(s)
+	}
+
+	is.True(IsSortedBy([]string{"a", "bb", "ccc"}, length))
+	is.True(IsSortedBy([]string{"ccc", "bb", "a"}, length))
+	is.False(IsSortedBy([]string{"bb", "a", "ccc"}, length))
+	is.True(IsSortedBy([]string{}, length))
+}
+
+func TestIsSortedFunc(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	length := func(s string) int {
+		return len(s)
+	}
+
+	is.True(IsSortedFunc([]string{"a", "bb", "ccc"}, By(length).Asc()))
+	is.False(IsSortedFunc([]string{"ccc", "bb", "a"}, By(length).Asc()))
+	is.True(IsSortedFunc([]string{"ccc", "bb", "a"}, By(length).Desc()))
+}
@@ -0,0 +1,314 @@
+package kit
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Comparator 比较函数。当 a 小于 b 时返回负数，相等时返回 0，大于时返回正数。
+type Comparator[T any] func(a T, b T) int
+
+// Reverse 返回顺序相反的比较函数。
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a T, b T) int {
+		return c(b, a)
+	}
+}
+
+// Compare 将多个比较函数组合为一个比较函数。
+// 依次使用每个比较函数进行比较，直到得到不相等的结果。
+func Compare[T any](comparators ...Comparator[T]) Comparator[T] {
+	return func(a T, b T) int {
+		for _, comparator := range comparators {
+			if result := comparator(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
+
+// Asc 从小到大排序的比较函数。
+func Asc[T constraints.Ordered](a T, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// BinarySearch 在从小到大有序的集合中二分查找 target。
+// 如果找到则返回其下标和 true，否则返回 target 应当插入的位置和 false。
+func BinarySearch[T constraints.Ordered](slice []T, target T) (int, bool) {
+	i := LowerBound(slice, target)
+	return i, i < len(slice) && slice[i] == target
+}
+
+// BinarySearchBy 使用比较函数在有序集合中二分查找 target。
+// 比较函数在元素小于 target 时返回负数，相等时返回 0，大于时返回正数。
+// 如果找到则返回其下标和 true，否则返回 target 应当插入的位置和 false。
+func BinarySearchBy[T any, K any](slice []T, target K, comparator func(T, K) int) (int, bool) {
+	i := sort.Search(len(slice), func(i int) bool {
+		return comparator(slice[i], target) >= 0
+	})
+	return i, i < len(slice) && comparator(slice[i], target) == 0
+}
+
+// By 根据 iteratee 函数返回的键构造比较函数，通过 Asc 或 Desc 指定排序方向。
+//
+//	SortStableFunc(users, Compare(By(name).Asc(), By(age).Desc()))
+func By[T any, K constraints.Ordered](iteratee func(T) K) *OrderBy[T, K] {
+	return &OrderBy[T, K]{iteratee: iteratee}
+}
+
+// OrderBy 排序键的构造器，由 By 创建，可以保存后多次生成比较函数。
+type OrderBy[T any, K constraints.Ordered] struct {
+	iteratee  func(T) K
+	zeroFirst bool
+	zeroLast  bool
+}
+
+// ZeroFirst 无论排序方向如何，键为零值的元素总是排在最前面。
+func (o *OrderBy[T, K]) ZeroFirst() *OrderBy[T, K] {
+	o.zeroFirst, o.zeroLast = true, false
+	return o
+}
+
+// ZeroLast 无论排序方向如何，键为零值的元素总是排在最后面。
+func (o *OrderBy[T, K]) ZeroLast() *OrderBy[T, K] {
+	o.zeroFirst, o.zeroLast = false, true
+	return o
+}
+
+// Asc 返回按键从小到大排序的比较函数。
+func (o *OrderBy[T, K]) Asc() Comparator[T] {
+	return o.comparator(false)
+}
+
+// Desc 返回按键从大到小排序的比较函数。
+func (o *OrderBy[T, K]) Desc() Comparator[T] {
+	return o.comparator(true)
+}
+
+func (o *OrderBy[T, K]) comparator(desc bool) Comparator[T] {
+	iteratee, zeroFirst, zeroLast := o.iteratee, o.zeroFirst, o.zeroLast
+
+	return func(a T, b T) int {
+		var zero K
+		ka, kb := iteratee(a), iteratee(b)
+
+		if zeroFirst || zeroLast {
+			za, zb := ka == zero, kb == zero
+
+			switch {
+			case za && zb:
+				return 0
+			case za:
+				return Ternary(zeroFirst, -1, 1)
+			case zb:
+				return Ternary(zeroFirst, 1, -1)
+			}
+		}
+
+		result := Asc(ka, kb)
+		return Ternary(desc, -result, result)
+	}
+}
+
+// Desc 从大到小排序的比较函数。
+func Desc[T constraints.Ordered](a T, b T) int {
+	return Asc(b, a)
+}
+
+// InsertSorted 将元素插入到从小到大有序的集合中，并保持集合有序。
+// 相等的元素会被插入到已有元素之后。
+func InsertSorted[T constraints.Ordered](slice []T, item T) []T {
+	return InsertAt(slice, UpperBound(slice, item), item)
+}
+
+// LowerBound 返回从小到大有序的集合中第一个不小于 target 的元素下标。
+// 如果不存在这样的元素，则返回集合的长度。
+func LowerBound[T constraints.Ordered](slice []T, target T) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return slice[i] >= target
+	})
+}
+
+// NilFirst 将比较函数扩展到指针类型，nil 指针排在最前面，非 nil 指针使用 comparator 比较所指向的值。
+func NilFirst[T any](comparator Comparator[T]) Comparator[*T] {
+	return nilComparator(comparator, -1)
+}
+
+// NilLast 将比较函数扩展到指针类型，nil 指针排在最后面，非 nil 指针使用 comparator 比较所指向的值。
+func NilLast[T any](comparator Comparator[T]) Comparator[*T] {
+	return nilComparator(comparator, 1)
+}
+
+func nilComparator[T any](comparator Comparator[T], nilOrder int) Comparator[*T] {
+	return func(a *T, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		}
+		return comparator(*a, *b)
+	}
+}
+
+// Sort 将集合从小到大排序。
+func Sort[T constraints.Ordered](slice []T) []T {
+	sort.Slice(slice, func(i, j int) bool {
+		return slice[i] < slice[j]
+	})
+	return slice
+}
+
+// SortBy 根据 iteratee 函数返回的键将集合从小到大进行稳定排序。
+func SortBy[T any, K constraints.Ordered](slice []T, iteratee func(T) K) []T {
+	return SortStableFunc(slice, By(iteratee).Asc())
+}
+
+// SortByDesc 根据 iteratee 函数返回的键将集合从大到小进行稳定排序。
+func SortByDesc[T any, K constraints.Ordered](slice []T, iteratee func(T) K) []T {
+	return SortStableFunc(slice, By(iteratee).Desc())
+}
+
+// SortStableFunc 使用比较函数对集合进行稳定排序，相等的元素保持原有顺序。
+func SortStableFunc[T any](slice []T, comparator Comparator[T]) []T {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return comparator(slice[i], slice[j]) < 0
+	})
+	return slice
+}
+
+// SortedDifference 返回两个从小到大有序集合之间的差异，以线性时间归并实现。
+// 第一个值是 compare 中不存在的元素的集合。
+// 第二个值是 slice 中不存在的元素的集合。
+func SortedDifference[T constraints.Ordered](slice []T, compare []T) ([]T, []T) {
+	left := []T{}
+	right := []T{}
+
+	i, j := 0, 0
+	for i < len(slice) && j < len(compare) {
+		switch {
+		case slice[i] < compare[j]:
+			left = append(left, slice[i])
+			i++
+		case slice[i] > compare[j]:
+			right = append(right, compare[j])
+			j++
+		default:
+			// 跳过两侧所有相等的元素
+			item := slice[i]
+			for i < len(slice) && slice[i] == item {
+				i++
+			}
+			for j < len(compare) && compare[j] == item {
+				j++
+			}
+		}
+	}
+
+	left = append(left, slice[i:]...)
+	right = append(right, compare[j:]...)
+
+	return left, right
+}
+
+// SortedIntersect 返回所有从小到大有序集合中都存在的元素切片，以线性时间归并实现。
+// 结果去重且保持有序。
+func SortedIntersect[T constraints.Ordered](slices ...[]T) []T {
+	size := len(slices)
+	if size == 0 {
+		return []T{}
+	}
+
+	result := sortedUnique(slices[0])
+
+	for k := 1; k < size; k++ {
+		slice := slices[k]
+		merged := make([]T, 0, Min(len(result), len(slice)))
+
+		i, j := 0, 0
+		for i < len(result) && j < len(slice) {
+			switch {
+			case result[i] < slice[j]:
+				i++
+			case result[i] > slice[j]:
+				j++
+			default:
+				merged = append(merged, result[i])
+				i++
+				j++
+			}
+		}
+
+		result = merged
+	}
+
+	return result
+}
+
+// SortedUnion 返回所有从小到大有序集合中的不同元素，以线性时间归并实现。
+// 结果去重且保持有序。
+func SortedUnion[T constraints.Ordered](slices ...[]T) []T {
+	result := []T{}
+
+	for _, slice := range slices {
+		merged := make([]T, 0, len(result)+len(slice))
+
+		i, j := 0, 0
+		for i < len(result) || j < len(slice) {
+			var item T
+
+			switch {
+			case j >= len(slice) || (i < len(result) && result[i] < slice[j]):
+				item = result[i]
+				i++
+			case i >= len(result) || result[i] > slice[j]:
+				item = slice[j]
+				j++
+			default:
+				item = result[i]
+				i++
+				j++
+			}
+
+			if len(merged) == 0 || merged[len(merged)-1] != item {
+				merged = append(merged, item)
+			}
+		}
+
+		result = merged
+	}
+
+	return result
+}
+
+// UpperBound 返回从小到大有序的集合中第一个大于 target 的元素下标。
+// 如果不存在这样的元素，则返回集合的长度。
+func UpperBound[T constraints.Ordered](slice []T, target T) int {
+	return sort.Search(len(slice), func(i int) bool {
+		return slice[i] > target
+	})
+}
+
+// sortedUnique 对有序集合进行去重。
+func sortedUnique[T constraints.Ordered](slice []T) []T {
+	result := make([]T, 0, len(slice))
+
+	for i, item := range slice {
+		if i == 0 || slice[i-1] != item {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,194 @@
+package kit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sortUser struct {
+	name string
+	age  int
+}
+
+func TestSort(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	result1 := Sort([]int{3, 1, 2, 5, 4})
+	result2 := Sort([]string{"b", "c", "a"})
+	result3 := Sort([]int{})
+
+	is.Equal(result1, []int{1, 2, 3, 4, 5})
+	is.Equal(result2, []string{"a", "b", "c"})
+	is.Equal(result3, []int{})
+}
+
+func TestSortBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	users := []sortUser{{"foo", 20}, {"bar", 18}, {"baz", 20}, {"qux", 19}}
+	age := func(u sortUser) int {
+		return u.age
+	}
+
+	result1 := SortBy(append([]sortUser{}, users...), age)
+	result2 := SortByDesc(append([]sortUser{}, users...), age)
+
+	is.Equal(result1, []sortUser{{"bar", 18}, {"qux", 19}, {"foo", 20}, {"baz", 20}})
+	is.Equal(result2, []sortUser{{"foo", 20}, {"baz", 20}, {"qux", 19}, {"bar", 18}})
+}
+
+func TestSortStableFunc(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	name := func(u sortUser) string {
+		return u.name
+	}
+	age := func(u sortUser) int {
+		return u.age
+	}
+
+	users := []sortUser{{"foo", 20}, {"bar", 18}, {"foo", 18}, {"bar", 20}, {"", 30}}
+
+	result1 := SortStableFunc(append([]sortUser{}, users...), Compare(By(name).Asc(), By(age).Desc()))
+	result2 := SortStableFunc(append([]sortUser{}, users...), Compare(By(name).ZeroLast().Asc(), By(age).Asc()))
+	result3 := SortStableFunc(append([]sortUser{}, users...), Compare(By(name).ZeroFirst().Desc(), By(age).Asc()))
+	result4 := SortStableFunc(append([]sortUser{}, users...), Compare(By(age).Asc(), By(name).Asc()).Reverse())
+
+	is.Equal(result1, []sortUser{{"", 30}, {"bar", 20}, {"bar", 18}, {"foo", 20}, {"foo", 18}})
+	is.Equal(result2, []sortUser{{"bar", 18}, {"bar", 20}, {"foo", 18}, {"foo", 20}, {"", 30}})
+	is.Equal(result3, []sortUser{{"", 30}, {"foo", 18}, {"foo", 20}, {"bar", 18}, {"bar", 20}})
+	is.Equal(result4, []sortUser{{"", 30}, {"foo", 20}, {"bar", 20}, {"foo", 18}, {"bar", 18}})
+}
+
+func TestNilFirst(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	one, two := 1, 2
+
+	result1 := SortStableFunc([]*int{&two, nil, &one}, NilFirst(Asc[int]))
+	result2 := SortStableFunc([]*int{&two, nil, &one}, NilLast(Asc[int]))
+	result3 := SortStableFunc([]*int{&one, nil, &two}, NilLast(Desc[int]))
+
+	is.Equal(result1, []*int{nil, &one, &two})
+	is.Equal(result2, []*int{&one, &two, nil})
+	is.Equal(result3, []*int{&two, &one, nil})
+}
+
+func TestBinarySearch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	slice := []int{1, 3, 3, 5, 7}
+
+	i1, ok1 := BinarySearch(slice, 3)
+	i2, ok2 := BinarySearch(slice, 4)
+	i3, ok3 := BinarySearch(slice, 8)
+	i4, ok4 := BinarySearch([]int{}, 1)
+
+	is.Equal(1, i1)
+	is.True(ok1)
+	is.Equal(3, i2)
+	is.False(ok2)
+	is.Equal(5, i3)
+	is.False(ok3)
+	is.Equal(0, i4)
+	is.False(ok4)
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	users := []sortUser{{"bar", 18}, {"foo", 20}, {"baz", 25}}
+	comparator := func(u sortUser, age int) int {
+		return u.age - age
+	}
+
+	i1, ok1 := BinarySearchBy(users, 20, comparator)
+	i2, ok2 := BinarySearchBy(users, 21, comparator)
+
+	is.Equal(1, i1)
+	is.True(ok1)
+	is.Equal(2, i2)
+	is.False(ok2)
+}
+
+func TestLowerBound(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	slice := []int{1, 3, 3, 5}
+
+	is.Equal(0, LowerBound(slice, 0))
+	is.Equal(1, LowerBound(slice, 3))
+	is.Equal(3, LowerBound(slice, 4))
+	is.Equal(4, LowerBound(slice, 6))
+	is.Equal(0, LowerBound([]int{}, 6))
+}
+
+func TestUpperBound(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	slice := []int{1, 3, 3, 5}
+
+	is.Equal(0, UpperBound(slice, 0))
+	is.Equal(3, UpperBound(slice, 3))
+	is.Equal(3, UpperBound(slice, 4))
+	is.Equal(4, UpperBound(slice, 5))
+	is.Equal(0, UpperBound([]int{}, 6))
+}
+
+func TestInsertSorted(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]int{1, 2, 3, 4}, InsertSorted([]int{1, 3, 4}, 2))
+	is.Equal([]int{0, 1, 3}, InsertSorted([]int{1, 3}, 0))
+	is.Equal([]int{1, 3, 5}, InsertSorted([]int{1, 3}, 5))
+	is.Equal([]int{1, 3, 3}, InsertSorted([]int{1, 3}, 3))
+	is.Equal([]int{1}, InsertSorted([]int{}, 1))
+}
+
+func TestSortedUnion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]int{0, 1, 2, 3, 4, 5, 10}, SortedUnion([]int{0, 1, 2, 3, 4, 5}, []int{0, 2, 10}))
+	is.Equal([]int{0, 1, 2, 3, 4}, SortedUnion([]int{0, 1, 1, 3}, []int{2, 2}, []int{3, 4}))
+	is.Equal([]int{1, 2}, SortedUnion([]int{1, 2}))
+	is.Equal([]int{}, SortedUnion[int]())
+}
+
+func TestSortedIntersect(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]int{0, 2}, SortedIntersect([]int{0, 1, 2, 3, 4, 5}, []int{0, 2, 10}))
+	is.Equal([]int{3}, SortedIntersect([]int{1, 3, 3, 5}, []int{3, 3, 5}, []int{0, 3}))
+	is.Equal([]int{}, SortedIntersect([]int{1, 2}, []int{3, 4}))
+	is.Equal([]int{1, 2}, SortedIntersect([]int{1, 1, 2}))
+	is.Equal([]int{}, SortedIntersect[int]())
+}
+
+func TestSortedDifference(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	left1, right1 := SortedDifference([]int{0, 1, 2, 3, 4, 5}, []int{0, 2, 6})
+	is.Equal([]int{1, 3, 4, 5}, left1)
+	is.Equal([]int{6}, right1)
+
+	left2, right2 := SortedDifference([]int{1, 2, 3, 4, 5}, []int{0, 6})
+	is.Equal([]int{1, 2, 3, 4, 5}, left2)
+	is.Equal([]int{0, 6}, right2)
+
+	left3, right3 := SortedDifference([]int{0, 1, 1, 2}, []int{0, 1, 2})
+	is.Equal([]int{}, left3)
+	is.Equal([]int{}, right3)
+}
@@ -0,0 +1,440 @@
+package kit
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// SortedMap 按键从小到大有序的映射，基于左倾红黑树实现。
+// 查找、插入、删除以及 Floor、Ceiling、Rank、Select 等操作的时间复杂度均为 O(log n)。
+// 零值可以直接使用。
+type SortedMap[K constraints.Ordered, V any] struct {
+	root *treeNode[K, V]
+}
+
+// treeNode 红黑树节点，size 为以该节点为根的子树的节点个数。
+type treeNode[K constraints.Ordered, V any] struct {
+	key         K
+	value       V
+	left, right *treeNode[K, V]
+	red         bool
+	size        int
+}
+
+// NewSortedMap 使用给定的键值对创建有序映射。
+func NewSortedMap[K constraints.Ordered, V any](entries ...Entry[K, V]) *SortedMap[K, V] {
+	m := &SortedMap[K, V]{}
+
+	for _, entry := range entries {
+		m.Set(entry.Key, entry.Value)
+	}
+
+	return m
+}
+
+// Len 返回键值对的个数。
+func (m *SortedMap[K, V]) Len() int {
+	return m.root.len()
+}
+
+// Get 返回给定键的值，键不存在时返回零值和 false。
+func (m *SortedMap[K, V]) Get(key K) (V, bool) {
+	if n := m.find(key); n != nil {
+		return n.value, true
+	}
+	return Empty[V](), false
+}
+
+// Has 判断键是否存在。
+func (m *SortedMap[K, V]) Has(key K) bool {
+	return m.find(key) != nil
+}
+
+// Set 设置键值对，已存在的键会更新值。
+func (m *SortedMap[K, V]) Set(key K, value V) {
+	m.root = m.root.put(key, value)
+	m.root.red = false
+}
+
+// Delete 删除给定的键，返回键是否存在。
+func (m *SortedMap[K, V]) Delete(key K) bool {
+	if !m.Has(key) {
+		return false
+	}
+
+	if !m.root.left.isRed() && !m.root.right.isRed() {
+		m.root.red = true
+	}
+
+	m.root = m.root.delete(key)
+	if m.root != nil {
+		m.root.red = false
+	}
+
+	return true
+}
+
+// Min 返回键最小的键值对，映射为空时返回 false。
+func (m *SortedMap[K, V]) Min() (Entry[K, V], bool) {
+	if m.root == nil {
+		return Entry[K, V]{}, false
+	}
+	return m.root.min().entry(), true
+}
+
+// Max 返回键最大的键值对，映射为空时返回 false。
+func (m *SortedMap[K, V]) Max() (Entry[K, V], bool) {
+	n := m.root
+	if n == nil {
+		return Entry[K, V]{}, false
+	}
+
+	for n.right != nil {
+		n = n.right
+	}
+
+	return n.entry(), true
+}
+
+// Floor 返回键小于等于 key 的最大键值对，不存在时返回 false。
+func (m *SortedMap[K, V]) Floor(key K) (Entry[K, V], bool) {
+	var floor *treeNode[K, V]
+
+	for n := m.root; n != nil; {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			floor = n
+			n = n.right
+		default:
+			return n.entry(), true
+		}
+	}
+
+	if floor == nil {
+		return Entry[K, V]{}, false
+	}
+	return floor.entry(), true
+}
+
+// Ceiling 返回键大于等于 key 的最小键值对，不存在时返回 false。
+func (m *SortedMap[K, V]) Ceiling(key K) (Entry[K, V], bool) {
+	var ceiling *treeNode[K, V]
+
+	for n := m.root; n != nil; {
+		switch {
+		case key < n.key:
+			ceiling = n
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n.entry(), true
+		}
+	}
+
+	if ceiling == nil {
+		return Entry[K, V]{}, false
+	}
+	return ceiling.entry(), true
+}
+
+// Range 按键从小到大返回键在闭区间 [lo, hi] 内的所有键值对。
+func (m *SortedMap[K, V]) Range(lo K, hi K) []Entry[K, V] {
+	result := []Entry[K, V]{}
+
+	m.root.ascendRange(lo, hi, func(n *treeNode[K, V]) bool {
+		result = append(result, n.entry())
+		return true
+	})
+
+	return result
+}
+
+// Rank 返回小于 key 的键的个数。
+func (m *SortedMap[K, V]) Rank(key K) int {
+	rank := 0
+
+	for n := m.root; n != nil; {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			rank += 1 + n.left.len()
+			n = n.right
+		default:
+			return rank + n.left.len()
+		}
+	}
+
+	return rank
+}
+
+// Select 返回从小到大排序后下标为 i 的键值对，下标越界时返回 false。
+func (m *SortedMap[K, V]) Select(i int) (Entry[K, V], bool) {
+	if i < 0 || i >= m.Len() {
+		return Entry[K, V]{}, false
+	}
+
+	n := m.root
+	for {
+		size := n.left.len()
+
+		switch {
+		case i < size:
+			n = n.left
+		case i > size:
+			i -= size + 1
+			n = n.right
+		default:
+			return n.entry(), true
+		}
+	}
+}
+
+// Ascend 按键从小到大遍历所有键值对，当 iteratee 返回 false 时停止遍历。
+func (m *SortedMap[K, V]) Ascend(iteratee func(key K, value V) bool) {
+	m.root.ascend(func(n *treeNode[K, V]) bool {
+		return iteratee(n.key, n.value)
+	})
+}
+
+// Descend 按键从大到小遍历所有键值对，当 iteratee 返回 false 时停止遍历。
+func (m *SortedMap[K, V]) Descend(iteratee func(key K, value V) bool) {
+	m.root.descend(func(n *treeNode[K, V]) bool {
+		return iteratee(n.key, n.value)
+	})
+}
+
+// Keys 按从小到大的顺序返回所有的键。
+func (m *SortedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+
+	m.Ascend(func(key K, _ V) bool {
+		keys = append(keys, key)
+		return true
+	})
+
+	return keys
+}
+
+// Values 按键从小到大的顺序返回所有的值。
+func (m *SortedMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Len())
+
+	m.Ascend(func(_ K, value V) bool {
+		values = append(values, value)
+		return true
+	})
+
+	return values
+}
+
+// Entries 按键从小到大的顺序返回所有的键值对。
+func (m *SortedMap[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.Len())
+
+	m.Ascend(func(key K, value V) bool {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+		return true
+	})
+
+	return entries
+}
+
+// ToMap 将有序映射转换为普通的 map。
+func (m *SortedMap[K, V]) ToMap() map[K]V {
+	return FromEntries(m.Entries())
+}
+
+func (m *SortedMap[K, V]) find(key K) *treeNode[K, V] {
+	for n := m.root; n != nil; {
+		switch {
+		case key < n.key:
+			n = n.left
+		case key > n.key:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func (n *treeNode[K, V]) entry() Entry[K, V] {
+	return Entry[K, V]{Key: n.key, Value: n.value}
+}
+
+func (n *treeNode[K, V]) isRed() bool {
+	return n != nil && n.red
+}
+
+func (n *treeNode[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func (n *treeNode[K, V]) min() *treeNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *treeNode[K, V]) put(key K, value V) *treeNode[K, V] {
+	if n == nil {
+		return &treeNode[K, V]{key: key, value: value, red: true, size: 1}
+	}
+
+	switch {
+	case key < n.key:
+		n.left = n.left.put(key, value)
+	case key > n.key:
+		n.right = n.right.put(key, value)
+	default:
+		n.value = value
+	}
+
+	return n.balance()
+}
+
+// delete 删除给定的键，调用方需保证键存在。
+func (n *treeNode[K, V]) delete(key K) *treeNode[K, V] {
+	if key < n.key {
+		if !n.left.isRed() && !n.left.left.isRed() {
+			n = n.moveRedLeft()
+		}
+		n.left = n.left.delete(key)
+		return n.balance()
+	}
+
+	if n.left.isRed() {
+		n = n.rotateRight()
+	}
+	if key == n.key && n.right == nil {
+		return nil
+	}
+	if !n.right.isRed() && !n.right.left.isRed() {
+		n = n.moveRedRight()
+	}
+
+	if key == n.key {
+		successor := n.right.min()
+		n.key, n.value = successor.key, successor.value
+		n.right = n.right.deleteMin()
+	} else {
+		n.right = n.right.delete(key)
+	}
+
+	return n.balance()
+}
+
+func (n *treeNode[K, V]) deleteMin() *treeNode[K, V] {
+	if n.left == nil {
+		return nil
+	}
+
+	if !n.left.isRed() && !n.left.left.isRed() {
+		n = n.moveRedLeft()
+	}
+	n.left = n.left.deleteMin()
+
+	return n.balance()
+}
+
+func (n *treeNode[K, V]) rotateLeft() *treeNode[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.size = 1 + n.left.len() + n.right.len()
+	return x
+}
+
+func (n *treeNode[K, V]) rotateRight() *treeNode[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	x.size = n.size
+	n.size = 1 + n.left.len() + n.right.len()
+	return x
+}
+
+func (n *treeNode[K, V]) flipColors() {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+func (n *treeNode[K, V]) moveRedLeft() *treeNode[K, V] {
+	n.flipColors()
+	if n.right.left.isRed() {
+		n.right = n.right.rotateRight()
+		n = n.rotateLeft()
+		n.flipColors()
+	}
+	return n
+}
+
+func (n *treeNode[K, V]) moveRedRight() *treeNode[K, V] {
+	n.flipColors()
+	if n.left.left.isRed() {
+		n = n.rotateRight()
+		n.flipColors()
+	}
+	return n
+}
+
+// balance 恢复左倾红黑树的性质并更新子树大小。
+func (n *treeNode[K, V]) balance() *treeNode[K, V] {
+	if n.right.isRed() && !n.left.isRed() {
+		n = n.rotateLeft()
+	}
+	if n.left.isRed() && n.left.left.isRed() {
+		n = n.rotateRight()
+	}
+	if n.left.isRed() && n.right.isRed() {
+		n.flipColors()
+	}
+
+	n.size = 1 + n.left.len() + n.right.len()
+	return n
+}
+
+func (n *treeNode[K, V]) ascend(iteratee func(*treeNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.ascend(iteratee) && iteratee(n) && n.right.ascend(iteratee)
+}
+
+func (n *treeNode[K, V]) descend(iteratee func(*treeNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.right.descend(iteratee) && iteratee(n) && n.left.descend(iteratee)
+}
+
+func (n *treeNode[K, V]) ascendRange(lo K, hi K, iteratee func(*treeNode[K, V]) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if lo < n.key && !n.left.ascendRange(lo, hi, iteratee) {
+		return false
+	}
+	if lo <= n.key && n.key <= hi && !iteratee(n) {
+		return false
+	}
+	if n.key < hi {
+		return n.right.ascendRange(lo, hi, iteratee)
+	}
+
+	return true
+}
@@ -0,0 +1,191 @@
+package kit
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// checkTreeNode 校验左倾红黑树的性质，返回子树的黑色高度。
+func checkTreeNode(is *assert.Assertions, n *treeNode[int, int]) int {
+	if n == nil {
+		return 0
+	}
+
+	is.False(n.right.isRed(), "right link must be black")
+	is.False(n.red && n.left.isRed(), "no two consecutive red links")
+	is.Equal(1+n.left.len()+n.right.len(), n.size)
+
+	if n.left != nil {
+		is.Less(n.left.key, n.key)
+	}
+	if n.right != nil {
+		is.Greater(n.right.key, n.key)
+	}
+
+	left := checkTreeNode(is, n.left)
+	right := checkTreeNode(is, n.right)
+	is.Equal(left, right, "perfect black balance")
+
+	return left + Ternary(n.red, 0, 1)
+}
+
+func TestSortedMap(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewSortedMap(Entry[string, int]{"b", 2}, Entry[string, int]{"c", 3}, Entry[string, int]{"a", 1})
+	m.Set("b", 20)
+
+	is.Equal(3, m.Len())
+	is.Equal([]string{"a", "b", "c"}, m.Keys())
+	is.Equal([]int{1, 20, 3}, m.Values())
+	is.Equal(map[string]int{"a": 1, "b": 20, "c": 3}, m.ToMap())
+
+	v, ok := m.Get("b")
+	is.Equal(20, v)
+	is.True(ok)
+
+	_, ok = m.Get("d")
+	is.False(ok)
+
+	is.True(m.Delete("a"))
+	is.False(m.Delete("a"))
+	is.Equal([]Entry[string, int]{{"b", 20}, {"c", 3}}, m.Entries())
+
+	var empty SortedMap[int, int]
+	is.Equal(0, empty.Len())
+	is.False(empty.Delete(1))
+	_, ok = empty.Min()
+	is.False(ok)
+	_, ok = empty.Max()
+	is.False(ok)
+	_, ok = empty.Select(0)
+	is.False(ok)
+}
+
+func TestSortedMapQuery(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewSortedMap[int, string]()
+	for _, k := range []int{50, 10, 30, 20, 40} {
+		m.Set(k, ToString(k))
+	}
+
+	min, ok := m.Min()
+	is.True(ok)
+	is.Equal(Entry[int, string]{10, "10"}, min)
+
+	max, ok := m.Max()
+	is.True(ok)
+	is.Equal(Entry[int, string]{50, "50"}, max)
+
+	floor, ok := m.Floor(35)
+	is.True(ok)
+	is.Equal(30, floor.Key)
+	floor, ok = m.Floor(30)
+	is.True(ok)
+	is.Equal(30, floor.Key)
+	_, ok = m.Floor(5)
+	is.False(ok)
+
+	ceiling, ok := m.Ceiling(35)
+	is.True(ok)
+	is.Equal(40, ceiling.Key)
+	ceiling, ok = m.Ceiling(40)
+	is.True(ok)
+	is.Equal(40, ceiling.Key)
+	_, ok = m.Ceiling(55)
+	is.False(ok)
+
+	is.Equal([]Entry[int, string]{{20, "20"}, {30, "30"}, {40, "40"}}, m.Range(15, 40))
+	is.Equal([]Entry[int, string]{}, m.Range(41, 49))
+	is.Equal([]Entry[int, string]{}, m.Range(40, 20))
+
+	is.Equal(0, m.Rank(5))
+	is.Equal(2, m.Rank(30))
+	is.Equal(3, m.Rank(35))
+	is.Equal(5, m.Rank(60))
+
+	selected, ok := m.Select(2)
+	is.True(ok)
+	is.Equal(30, selected.Key)
+	_, ok = m.Select(5)
+	is.False(ok)
+	_, ok = m.Select(-1)
+	is.False(ok)
+}
+
+func TestSortedMapIterate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := NewSortedMap[int, int]()
+	for i := 5; i > 0; i-- {
+		m.Set(i, i*i)
+	}
+
+	ascend := []int{}
+	m.Ascend(func(key int, value int) bool {
+		ascend = append(ascend, key)
+		return key < 3
+	})
+	is.Equal([]int{1, 2, 3}, ascend)
+
+	descend := []int{}
+	m.Descend(func(key int, value int) bool {
+		descend = append(descend, value)
+		return true
+	})
+	is.Equal([]int{25, 16, 9, 4, 1}, descend)
+}
+
+func TestSortedMapRandom(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := rand.New(rand.NewSource(1))
+	m := NewSortedMap[int, int]()
+	expected := map[int]int{}
+
+	for i := 0; i < 2000; i++ {
+		key := r.Intn(200)
+
+		if r.Intn(3) == 0 {
+			_, exist := expected[key]
+			is.Equal(exist, m.Delete(key))
+			delete(expected, key)
+		} else {
+			m.Set(key, i)
+			expected[key] = i
+		}
+
+		if i%100 == 0 {
+			checkTreeNode(is, m.root)
+		}
+	}
+
+	checkTreeNode(is, m.root)
+
+	keys := Keys(expected)
+	sort.Ints(keys)
+
+	is.Equal(keys, m.Keys())
+	is.Equal(expected, m.ToMap())
+
+	for i, key := range keys {
+		is.Equal(i, m.Rank(key))
+
+		entry, ok := m.Select(i)
+		is.True(ok)
+		is.Equal(key, entry.Key)
+	}
+
+	for _, key := range keys {
+		is.True(m.Delete(key))
+	}
+	is.Equal(0, m.Len())
+}
@@ -0,0 +1,554 @@
+package kit
+
+// T2 根据给定的 2 个元素创建元组。
+func T2[A any, B any](a A, b B) Tuple2[A, B] {
+	return Tuple2[A, B]{A: a, B: b}
+}
+
+// T3 根据给定的 3 个元素创建元组。
+func T3[A any, B any, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return Tuple3[A, B, C]{A: a, B: b, C: c}
+}
+
+// T4 根据给定的 4 个元素创建元组。
+func T4[A any, B any, C any, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{A: a, B: b, C: c, D: d}
+}
+
+// T5 根据给定的 5 个元素创建元组。
+func T5[A any, B any, C any, D any, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{A: a, B: b, C: c, D: d, E: e}
+}
+
+// T6 根据给定的 6 个元素创建元组。
+func T6[A any, B any, C any, D any, E any, F any](a A, b B, c C, d D, e E, f F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{A: a, B: b, C: c, D: d, E: e, F: f}
+}
+
+// T7 根据给定的 7 个元素创建元组。
+func T7[A any, B any, C any, D any, E any, F any, G any](a A, b B, c C, d D, e E, f F, g G) Tuple7[A, B, C, D, E, F, G] {
+	return Tuple7[A, B, C, D, E, F, G]{A: a, B: b, C: c, D: d, E: e, F: f, G: g}
+}
+
+// T8 根据给定的 8 个元素创建元组。
+func T8[A any, B any, C any, D any, E any, F any, G any, H any](a A, b B, c C, d D, e E, f F, g G, h H) Tuple8[A, B, C, D, E, F, G, H] {
+	return Tuple8[A, B, C, D, E, F, G, H]{A: a, B: b, C: c, D: d, E: e, F: f, G: g, H: h}
+}
+
+// T9 根据给定的 9 个元素创建元组。
+func T9[A any, B any, C any, D any, E any, F any, G any, H any, I any](a A, b B, c C, d D, e E, f F, g G, h H, i I) Tuple9[A, B, C, D, E, F, G, H, I] {
+	return Tuple9[A, B, C, D, E, F, G, H, I]{A: a, B: b, C: c, D: d, E: e, F: f, G: g, H: h, I: i}
+}
+
+// Zip2 将 2 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip2[A any, B any](a []A, b []B) []Tuple2[A, B] {
+	size := Max(len(a), len(b))
+	result := make([]Tuple2[A, B], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple2[A, B]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+		})
+	}
+
+	return result
+}
+
+// Zip3 将 3 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip3[A any, B any, C any](a []A, b []B, c []C) []Tuple3[A, B, C] {
+	size := Max(len(a), len(b), len(c))
+	result := make([]Tuple3[A, B, C], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple3[A, B, C]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+		})
+	}
+
+	return result
+}
+
+// Zip4 将 4 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip4[A any, B any, C any, D any](a []A, b []B, c []C, d []D) []Tuple4[A, B, C, D] {
+	size := Max(len(a), len(b), len(c), len(d))
+	result := make([]Tuple4[A, B, C, D], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple4[A, B, C, D]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+		})
+	}
+
+	return result
+}
+
+// Zip5 将 5 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip5[A any, B any, C any, D any, E any](a []A, b []B, c []C, d []D, e []E) []Tuple5[A, B, C, D, E] {
+	size := Max(len(a), len(b), len(c), len(d), len(e))
+	result := make([]Tuple5[A, B, C, D, E], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple5[A, B, C, D, E]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+		})
+	}
+
+	return result
+}
+
+// Zip6 将 6 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip6[A any, B any, C any, D any, E any, F any](a []A, b []B, c []C, d []D, e []E, f []F) []Tuple6[A, B, C, D, E, F] {
+	size := Max(len(a), len(b), len(c), len(d), len(e), len(f))
+	result := make([]Tuple6[A, B, C, D, E, F], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple6[A, B, C, D, E, F]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+		})
+	}
+
+	return result
+}
+
+// Zip7 将 7 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip7[A any, B any, C any, D any, E any, F any, G any](a []A, b []B, c []C, d []D, e []E, f []F, g []G) []Tuple7[A, B, C, D, E, F, G] {
+	size := Max(len(a), len(b), len(c), len(d), len(e), len(f), len(g))
+	result := make([]Tuple7[A, B, C, D, E, F, G], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple7[A, B, C, D, E, F, G]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+			G: nthOrEmpty(g, index),
+		})
+	}
+
+	return result
+}
+
+// Zip8 将 8 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip8[A any, B any, C any, D any, E any, F any, G any, H any](a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H) []Tuple8[A, B, C, D, E, F, G, H] {
+	size := Max(len(a), len(b), len(c), len(d), len(e), len(f), len(g), len(h))
+	result := make([]Tuple8[A, B, C, D, E, F, G, H], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple8[A, B, C, D, E, F, G, H]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+			G: nthOrEmpty(g, index),
+			H: nthOrEmpty(h, index),
+		})
+	}
+
+	return result
+}
+
+// Zip9 将 9 个切片按下标组合为元组切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func Zip9[A any, B any, C any, D any, E any, F any, G any, H any, I any](a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I) []Tuple9[A, B, C, D, E, F, G, H, I] {
+	size := Max(len(a), len(b), len(c), len(d), len(e), len(f), len(g), len(h), len(i))
+	result := make([]Tuple9[A, B, C, D, E, F, G, H, I], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple9[A, B, C, D, E, F, G, H, I]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+			G: nthOrEmpty(g, index),
+			H: nthOrEmpty(h, index),
+			I: nthOrEmpty(i, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest2 将 2 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest2[A any, B any](a []A, b []B) []Tuple2[A, B] {
+	size := Min(len(a), len(b))
+	result := make([]Tuple2[A, B], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple2[A, B]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest3 将 3 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest3[A any, B any, C any](a []A, b []B, c []C) []Tuple3[A, B, C] {
+	size := Min(len(a), len(b), len(c))
+	result := make([]Tuple3[A, B, C], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple3[A, B, C]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest4 将 4 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest4[A any, B any, C any, D any](a []A, b []B, c []C, d []D) []Tuple4[A, B, C, D] {
+	size := Min(len(a), len(b), len(c), len(d))
+	result := make([]Tuple4[A, B, C, D], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple4[A, B, C, D]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest5 将 5 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest5[A any, B any, C any, D any, E any](a []A, b []B, c []C, d []D, e []E) []Tuple5[A, B, C, D, E] {
+	size := Min(len(a), len(b), len(c), len(d), len(e))
+	result := make([]Tuple5[A, B, C, D, E], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple5[A, B, C, D, E]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest6 将 6 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest6[A any, B any, C any, D any, E any, F any](a []A, b []B, c []C, d []D, e []E, f []F) []Tuple6[A, B, C, D, E, F] {
+	size := Min(len(a), len(b), len(c), len(d), len(e), len(f))
+	result := make([]Tuple6[A, B, C, D, E, F], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple6[A, B, C, D, E, F]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest7 将 7 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest7[A any, B any, C any, D any, E any, F any, G any](a []A, b []B, c []C, d []D, e []E, f []F, g []G) []Tuple7[A, B, C, D, E, F, G] {
+	size := Min(len(a), len(b), len(c), len(d), len(e), len(f), len(g))
+	result := make([]Tuple7[A, B, C, D, E, F, G], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple7[A, B, C, D, E, F, G]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+			G: nthOrEmpty(g, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest8 将 8 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest8[A any, B any, C any, D any, E any, F any, G any, H any](a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H) []Tuple8[A, B, C, D, E, F, G, H] {
+	size := Min(len(a), len(b), len(c), len(d), len(e), len(f), len(g), len(h))
+	result := make([]Tuple8[A, B, C, D, E, F, G, H], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple8[A, B, C, D, E, F, G, H]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+			G: nthOrEmpty(g, index),
+			H: nthOrEmpty(h, index),
+		})
+	}
+
+	return result
+}
+
+// ZipShortest9 将 9 个切片按下标组合为元组切片。
+// 结果长度取最短的切片，较长切片多出的元素会被截断。
+func ZipShortest9[A any, B any, C any, D any, E any, F any, G any, H any, I any](a []A, b []B, c []C, d []D, e []E, f []F, g []G, h []H, i []I) []Tuple9[A, B, C, D, E, F, G, H, I] {
+	size := Min(len(a), len(b), len(c), len(d), len(e), len(f), len(g), len(h), len(i))
+	result := make([]Tuple9[A, B, C, D, E, F, G, H, I], 0, size)
+
+	for index := 0; index < size; index++ {
+		result = append(result, Tuple9[A, B, C, D, E, F, G, H, I]{
+			A: nthOrEmpty(a, index),
+			B: nthOrEmpty(b, index),
+			C: nthOrEmpty(c, index),
+			D: nthOrEmpty(d, index),
+			E: nthOrEmpty(e, index),
+			F: nthOrEmpty(f, index),
+			G: nthOrEmpty(g, index),
+			H: nthOrEmpty(h, index),
+			I: nthOrEmpty(i, index),
+		})
+	}
+
+	return result
+}
+
+// Unzip2 将元组切片拆分为 2 个切片，是 Zip2 的逆操作。
+func Unzip2[A any, B any](tuples []Tuple2[A, B]) ([]A, []B) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+	}
+
+	return a, b
+}
+
+// Unzip3 将元组切片拆分为 3 个切片，是 Zip3 的逆操作。
+func Unzip3[A any, B any, C any](tuples []Tuple3[A, B, C]) ([]A, []B, []C) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+	}
+
+	return a, b, c
+}
+
+// Unzip4 将元组切片拆分为 4 个切片，是 Zip4 的逆操作。
+func Unzip4[A any, B any, C any, D any](tuples []Tuple4[A, B, C, D]) ([]A, []B, []C, []D) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+	d := make([]D, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+		d = append(d, tuple.D)
+	}
+
+	return a, b, c, d
+}
+
+// Unzip5 将元组切片拆分为 5 个切片，是 Zip5 的逆操作。
+func Unzip5[A any, B any, C any, D any, E any](tuples []Tuple5[A, B, C, D, E]) ([]A, []B, []C, []D, []E) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+	d := make([]D, 0, size)
+	e := make([]E, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+		d = append(d, tuple.D)
+		e = append(e, tuple.E)
+	}
+
+	return a, b, c, d, e
+}
+
+// Unzip6 将元组切片拆分为 6 个切片，是 Zip6 的逆操作。
+func Unzip6[A any, B any, C any, D any, E any, F any](tuples []Tuple6[A, B, C, D, E, F]) ([]A, []B, []C, []D, []E, []F) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+	d := make([]D, 0, size)
+	e := make([]E, 0, size)
+	f := make([]F, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+		d = append(d, tuple.D)
+		e = append(e, tuple.E)
+		f = append(f, tuple.F)
+	}
+
+	return a, b, c, d, e, f
+}
+
+// Unzip7 将元组切片拆分为 7 个切片，是 Zip7 的逆操作。
+func Unzip7[A any, B any, C any, D any, E any, F any, G any](tuples []Tuple7[A, B, C, D, E, F, G]) ([]A, []B, []C, []D, []E, []F, []G) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+	d := make([]D, 0, size)
+	e := make([]E, 0, size)
+	f := make([]F, 0, size)
+	g := make([]G, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+		d = append(d, tuple.D)
+		e = append(e, tuple.E)
+		f = append(f, tuple.F)
+		g = append(g, tuple.G)
+	}
+
+	return a, b, c, d, e, f, g
+}
+
+// Unzip8 将元组切片拆分为 8 个切片，是 Zip8 的逆操作。
+func Unzip8[A any, B any, C any, D any, E any, F any, G any, H any](tuples []Tuple8[A, B, C, D, E, F, G, H]) ([]A, []B, []C, []D, []E, []F, []G, []H) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+	d := make([]D, 0, size)
+	e := make([]E, 0, size)
+	f := make([]F, 0, size)
+	g := make([]G, 0, size)
+	h := make([]H, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+		d = append(d, tuple.D)
+		e = append(e, tuple.E)
+		f = append(f, tuple.F)
+		g = append(g, tuple.G)
+		h = append(h, tuple.H)
+	}
+
+	return a, b, c, d, e, f, g, h
+}
+
+// Unzip9 将元组切片拆分为 9 个切片，是 Zip9 的逆操作。
+func Unzip9[A any, B any, C any, D any, E any, F any, G any, H any, I any](tuples []Tuple9[A, B, C, D, E, F, G, H, I]) ([]A, []B, []C, []D, []E, []F, []G, []H, []I) {
+	size := len(tuples)
+	a := make([]A, 0, size)
+	b := make([]B, 0, size)
+	c := make([]C, 0, size)
+	d := make([]D, 0, size)
+	e := make([]E, 0, size)
+	f := make([]F, 0, size)
+	g := make([]G, 0, size)
+	h := make([]H, 0, size)
+	i := make([]I, 0, size)
+
+	for _, tuple := range tuples {
+		a = append(a, tuple.A)
+		b = append(b, tuple.B)
+		c = append(c, tuple.C)
+		d = append(d, tuple.D)
+		e = append(e, tuple.E)
+		f = append(f, tuple.F)
+		g = append(g, tuple.G)
+		h = append(h, tuple.H)
+		i = append(i, tuple.I)
+	}
+
+	return a, b, c, d, e, f, g, h, i
+}
+
+// ZipWith 将两个切片按下标依次传入 iteratee 函数，返回由结果组成的切片。
+// 结果长度取最长的切片，较短切片缺失的位置使用零值填充。
+func ZipWith[A any, B any, R any](a []A, b []B, iteratee func(A, B) R) []R {
+	size := Max(len(a), len(b))
+	result := make([]R, 0, size)
+
+	for i := 0; i < size; i++ {
+		result = append(result, iteratee(nthOrEmpty(a, i), nthOrEmpty(b, i)))
+	}
+
+	return result
+}
+
+// Enumerate 返回由下标和元素组成的元组切片。
+func Enumerate[T any](slice []T) []Tuple2[int, T] {
+	result := make([]Tuple2[int, T], 0, len(slice))
+
+	for i, item := range slice {
+		result = append(result, Tuple2[int, T]{A: i, B: item})
+	}
+
+	return result
+}
+
+// nthOrEmpty 返回切片下标 i 处的元素，越界时返回零值。
+func nthOrEmpty[T any](slice []T, i int) T {
+	if i < 0 || i >= len(slice) {
+		return Empty[T]()
+	}
+
+	return slice[i]
+}
@@ -0,0 +1,114 @@
+package kit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestT(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r1 := T2("a", 1)
+	r2 := T3("a", 1, true)
+	r3 := T9("a", 1, true, 2.0, 'c', uint(3), int8(4), []int{5}, "i")
+
+	is.Equal(r1, Tuple2[string, int]{A: "a", B: 1})
+	is.Equal(r2, Tuple3[string, int, bool]{A: "a", B: 1, C: true})
+	is.Equal(r3.I, "i")
+	is.Equal(r3.H, []int{5})
+}
+
+func TestUnpack(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, b := T2("a", 1).Unpack()
+	is.Equal(a, "a")
+	is.Equal(b, 1)
+
+	c, d, e, f := T4(1, "b", true, 2.5).Unpack()
+	is.Equal(c, 1)
+	is.Equal(d, "b")
+	is.Equal(e, true)
+	is.Equal(f, 2.5)
+}
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r1 := Zip2([]string{"a", "b"}, []int{1, 2})
+	r2 := Zip2([]string{"a", "b", "c"}, []int{1})
+	r3 := Zip3([]string{"a"}, []int{1, 2}, []bool{true})
+	r4 := Zip2([]string{}, []int{})
+	r5 := Zip9([]int{1}, []int{2}, []int{3}, []int{4}, []int{5}, []int{6}, []int{7}, []int{8}, []int{9, 10})
+
+	is.Equal(r1, []Tuple2[string, int]{{A: "a", B: 1}, {A: "b", B: 2}})
+	is.Equal(r2, []Tuple2[string, int]{{A: "a", B: 1}, {A: "b", B: 0}, {A: "c", B: 0}})
+	is.Equal(r3, []Tuple3[string, int, bool]{{A: "a", B: 1, C: true}, {A: "", B: 2, C: false}})
+	is.Equal(r4, []Tuple2[string, int]{})
+	is.Equal(r5, []Tuple9[int, int, int, int, int, int, int, int, int]{
+		{A: 1, B: 2, C: 3, D: 4, E: 5, F: 6, G: 7, H: 8, I: 9},
+		{I: 10},
+	})
+}
+
+func TestZipShortest(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r1 := ZipShortest2([]string{"a", "b", "c"}, []int{1, 2})
+	r2 := ZipShortest3([]string{"a", "b"}, []int{1, 2}, []bool{})
+	r3 := ZipShortest4([]int{1, 2}, []int{3, 4}, []int{5, 6}, []int{7, 8, 9})
+
+	is.Equal(r1, []Tuple2[string, int]{{A: "a", B: 1}, {A: "b", B: 2}})
+	is.Equal(r2, []Tuple3[string, int, bool]{})
+	is.Equal(r3, []Tuple4[int, int, int, int]{{A: 1, B: 3, C: 5, D: 7}, {A: 2, B: 4, C: 6, D: 8}})
+}
+
+func TestUnzip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r1, r2 := Unzip2([]Tuple2[string, int]{{A: "a", B: 1}, {A: "b", B: 2}})
+	is.Equal(r1, []string{"a", "b"})
+	is.Equal(r2, []int{1, 2})
+
+	r3, r4, r5 := Unzip3([]Tuple3[string, int, bool]{})
+	is.Equal(r3, []string{})
+	is.Equal(r4, []int{})
+	is.Equal(r5, []bool{})
+
+	names, ages, active := Unzip3(Zip3([]string{"foo", "bar"}, []int{18, 20}, []bool{true}))
+	is.Equal(names, []string{"foo", "bar"})
+	is.Equal(ages, []int{18, 20})
+	is.Equal(active, []bool{true, false})
+}
+
+func TestZipWith(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r1 := ZipWith([]int{1, 2, 3}, []int{10, 20, 30}, func(a int, b int) int {
+		return a + b
+	})
+	r2 := ZipWith([]string{"a", "b"}, []int{1}, func(a string, b int) string {
+		return a + ":" + ToString(b)
+	})
+
+	is.Equal(r1, []int{11, 22, 33})
+	is.Equal(r2, []string{"a:1", "b:0"})
+}
+
+func TestEnumerate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r1 := Enumerate([]string{"a", "b"})
+	r2 := Enumerate([]string{})
+
+	is.Equal(r1, []Tuple2[int, string]{{A: 0, B: "a"}, {A: 1, B: "b"}})
+	is.Equal(r2, []Tuple2[int, string]{})
+}